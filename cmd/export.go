@@ -0,0 +1,338 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"charm.land/log/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+const (
+	exportFormatJSON  = "json"
+	exportFormatCSV   = "csv"
+	exportFormatTable = "table"
+)
+
+var (
+	exportFormat  string
+	exportView    string
+	exportSection string
+)
+
+// exportRow is a flattened row of a section, shared by PRs, issues and
+// notifications so all of them can be printed in the same shape.
+type exportRow struct {
+	Section   string    `json:"section"`
+	Type      string    `json:"type"`
+	Repo      string    `json:"repo"`
+	Number    int       `json:"number,omitempty"`
+	Title     string    `json:"title"`
+	Author    string    `json:"author,omitempty"`
+	State     string    `json:"state"`
+	Url       string    `json:"url"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+var exportHeader = []string{
+	"section",
+	"type",
+	"repo",
+	"number",
+	"title",
+	"author",
+	"state",
+	"url",
+	"updatedAt",
+}
+
+func (r exportRow) fields() []string {
+	number := ""
+	if r.Number != 0 {
+		number = strconv.Itoa(r.Number)
+	}
+	return []string{
+		r.Section,
+		r.Type,
+		r.Repo,
+		number,
+		r.Title,
+		r.Author,
+		r.State,
+		r.Url,
+		r.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the rows of the configured sections without starting the UI",
+	Long: `Fetch the configured PR, issue and notification sections and print their rows as JSON, CSV or a plain table.
+Useful for piping a section into scripts or cron jobs.`,
+	Example: `
+# Export all sections as JSON
+gh dash export
+
+# Export a single section as CSV
+gh dash export --view prs --section "Needs My Review" --format csv
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log.SetLevel(log.ErrorLevel)
+
+		// Checked before fetching so a typo doesn't cost a fetch of every section
+		if !isExportFormat(exportFormat) {
+			return fmt.Errorf("invalid format %q, must be one of: json, csv, table", exportFormat)
+		}
+		if exportView != "" && !isExportableView(config.ViewType(exportView)) {
+			return fmt.Errorf("invalid view %q, must be one of: prs, issues, notifications", exportView)
+		}
+
+		var repoPath string
+		if gitRepo, _, _ := getCurrentGitAndGitHubRepos(); gitRepo != nil {
+			repoPath = gitRepo.Path()
+		}
+		cfg, err := config.ParseConfig(config.Location{RepoPath: repoPath, ConfigFlag: cfgFlag})
+		if err != nil {
+			return err
+		}
+
+		rows, err := fetchExportRows(cfg, config.ViewType(exportView), exportSection)
+		if err != nil {
+			return err
+		}
+
+		return writeExportRows(os.Stdout, rows, exportFormat)
+	},
+}
+
+func isExportableView(view config.ViewType) bool {
+	return view == config.PRsView || view == config.IssuesView || view == config.NotificationsView
+}
+
+func isExportFormat(format string) bool {
+	return format == exportFormatJSON || format == exportFormatCSV || format == exportFormatTable
+}
+
+func shouldExportSection(view config.ViewType, title string, wantedView config.ViewType, wantedSection string) bool {
+	if wantedView != "" && view != wantedView {
+		return false
+	}
+	return wantedSection == "" || strings.EqualFold(title, wantedSection)
+}
+
+func sectionLimit(limit *int, defaultLimit int) int {
+	if limit != nil {
+		return *limit
+	}
+	return defaultLimit
+}
+
+func fetchExportRows(
+	cfg config.Config,
+	wantedView config.ViewType,
+	wantedSection string,
+) ([]exportRow, error) {
+	rows := make([]exportRow, 0)
+	matched := false
+
+	for _, s := range cfg.PRSections {
+		if !shouldExportSection(config.PRsView, s.Title, wantedView, wantedSection) {
+			continue
+		}
+		matched = true
+		res, err := data.FetchPullRequests(
+			section.EnrichSearchWithTemplateVars(s.Filters),
+			sectionLimit(s.Limit, cfg.Defaults.PrsLimit),
+			nil,
+			s.Host,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("fetching section %q: %w", s.Title, err)
+		}
+		for _, pr := range res.Prs {
+			rows = append(rows, exportRow{
+				Section:   s.Title,
+				Type:      string(config.PRsView),
+				Repo:      pr.Repository.NameWithOwner,
+				Number:    pr.Number,
+				Title:     pr.Title,
+				Author:    pr.Author.Login,
+				State:     pr.State,
+				Url:       pr.Url,
+				UpdatedAt: pr.UpdatedAt,
+			})
+		}
+	}
+
+	for _, s := range cfg.IssuesSections {
+		if !shouldExportSection(config.IssuesView, s.Title, wantedView, wantedSection) {
+			continue
+		}
+		matched = true
+		res, err := data.FetchIssues(
+			section.EnrichSearchWithTemplateVars(s.Filters),
+			sectionLimit(s.Limit, cfg.Defaults.IssuesLimit),
+			nil,
+			s.Host,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("fetching section %q: %w", s.Title, err)
+		}
+		for _, issue := range res.Issues {
+			rows = append(rows, exportRow{
+				Section:   s.Title,
+				Type:      string(config.IssuesView),
+				Repo:      issue.Repository.NameWithOwner,
+				Number:    issue.Number,
+				Title:     issue.Title,
+				Author:    issue.Author.Login,
+				State:     issue.State,
+				Url:       issue.Url,
+				UpdatedAt: issue.UpdatedAt,
+			})
+		}
+	}
+
+	for _, s := range cfg.NotificationsSections {
+		if !shouldExportSection(config.NotificationsView, s.Title, wantedView, wantedSection) {
+			continue
+		}
+		matched = true
+		notifications, err := fetchExportNotifications(
+			section.EnrichSearchWithTemplateVars(s.Filters),
+			sectionLimit(s.Limit, cfg.Defaults.NotificationsLimit),
+			cfg.IncludeReadNotifications,
+			s.Host,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("fetching section %q: %w", s.Title, err)
+		}
+		for _, n := range notifications {
+			state := "read"
+			if n.Unread {
+				state = "unread"
+			}
+			rows = append(rows, exportRow{
+				Section:   s.Title,
+				Type:      string(config.NotificationsView),
+				Repo:      n.GetRepoNameWithOwner(),
+				Title:     n.GetTitle(),
+				State:     state,
+				Url:       n.GetUrl(),
+				UpdatedAt: n.UpdatedAt,
+			})
+		}
+	}
+
+	if !matched && wantedSection != "" {
+		return nil, fmt.Errorf("no section named %q found in config", wantedSection)
+	}
+
+	return rows, nil
+}
+
+// fetchExportNotifications applies the same filters as the notifications
+// section, minus the session and bookmark state that only exists in the UI.
+func fetchExportNotifications(
+	search string,
+	limit int,
+	includeRead bool,
+	host string,
+) ([]data.NotificationData, error) {
+	filters := notificationssection.ParseNotificationFilters(search, includeRead)
+	if filters.IsDone {
+		return nil, nil
+	}
+
+	res, err := data.FetchNotifications(limit, filters.RepoFilters, filters.ReadState, nil, host)
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := make(map[string]bool, len(filters.ReasonFilters))
+	for _, reason := range filters.ReasonFilters {
+		reasons[reason] = true
+	}
+	doneStore := data.GetDoneStore()
+
+	notifications := make([]data.NotificationData, 0, len(res.Notifications))
+	for _, n := range res.Notifications {
+		if doneStore.IsDone(n.Id, n.UpdatedAt) {
+			continue
+		}
+		if len(reasons) > 0 && !reasons[n.Reason] {
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+func writeExportRows(w io.Writer, rows []exportRow, format string) error {
+	switch format {
+	case exportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	case exportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(exportHeader); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := cw.Write(row.fields()); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case exportFormatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(exportHeader, "\t")))
+		for _, row := range rows {
+			fmt.Fprintln(tw, strings.Join(row.fields(), "\t"))
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("invalid format %q, must be one of: json, csv, table", format)
+	}
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(
+		&exportFormat,
+		"format",
+		"f",
+		exportFormatJSON,
+		"output format: json, csv or table",
+	)
+	exportCmd.Flags().StringVar(
+		&exportView,
+		"view",
+		"",
+		"only export sections of this view: prs, issues or notifications",
+	)
+	exportCmd.Flags().StringVarP(
+		&exportSection,
+		"section",
+		"s",
+		"",
+		"only export the section with this title",
+	)
+
+	rootCmd.AddCommand(exportCmd)
+}
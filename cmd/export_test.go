@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func testExportRows() []exportRow {
+	return []exportRow{
+		{
+			Section:   "Needs My Review",
+			Type:      "prs",
+			Repo:      "dlvhdr/gh-dash",
+			Number:    42,
+			Title:     "Add export, with commas",
+			Author:    "dlvhdr",
+			State:     "OPEN",
+			Url:       "https://github.com/dlvhdr/gh-dash/pull/42",
+			UpdatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			Section:   "Inbox",
+			Type:      "notifications",
+			Repo:      "dlvhdr/gh-dash",
+			Title:     "Some notification",
+			State:     "unread",
+			Url:       "https://github.com/dlvhdr/gh-dash",
+			UpdatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+}
+
+func TestWriteExportRowsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeExportRows(&buf, testExportRows(), exportFormatJSON))
+
+	var got []exportRow
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	require.Equal(t, testExportRows(), got)
+	require.NotContains(t, buf.String(), `"number": 0`)
+}
+
+func TestWriteExportRowsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeExportRows(&buf, testExportRows(), exportFormatCSV))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, strings.Join(exportHeader, ","), lines[0])
+	require.Equal(
+		t,
+		`Needs My Review,prs,dlvhdr/gh-dash,42,"Add export, with commas",dlvhdr,OPEN,https://github.com/dlvhdr/gh-dash/pull/42,2025-01-02T03:04:05Z`,
+		lines[1],
+	)
+	require.Equal(
+		t,
+		`Inbox,notifications,dlvhdr/gh-dash,,Some notification,,unread,https://github.com/dlvhdr/gh-dash,2025-01-02T03:04:05Z`,
+		lines[2],
+	)
+}
+
+func TestWriteExportRowsTable(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeExportRows(&buf, testExportRows(), exportFormatTable))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.True(t, strings.HasPrefix(lines[0], "SECTION"))
+	require.Contains(t, lines[1], "Needs My Review")
+}
+
+func TestWriteExportRowsInvalidFormat(t *testing.T) {
+	var buf bytes.Buffer
+	require.Error(t, writeExportRows(&buf, testExportRows(), "xml"))
+}
+
+func TestIsExportFormat(t *testing.T) {
+	require.True(t, isExportFormat("json"))
+	require.True(t, isExportFormat("csv"))
+	require.True(t, isExportFormat("table"))
+	require.False(t, isExportFormat("xml"))
+	require.False(t, isExportFormat(""))
+}
+
+func TestShouldExportSection(t *testing.T) {
+	require.True(t, shouldExportSection(config.PRsView, "Mine", "", ""))
+	require.True(t, shouldExportSection(config.PRsView, "Mine", config.PRsView, "mine"))
+	require.False(t, shouldExportSection(config.PRsView, "Mine", config.IssuesView, ""))
+	require.False(t, shouldExportSection(config.PRsView, "Mine", "", "Other"))
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/mockserver"
+)
+
+// mockServerCmd serves recorded GitHub API responses for FF_MOCK_DATA
+var mockServerCmd = &cobra.Command{
+	Use:    "mock-server",
+	Hidden: true,
+	Short:  "Serve recorded GitHub API responses for offline development",
+	Long: fmt.Sprintf(`Serve recorded GitHub API responses on https://%s.
+Run gh-dash with FF_MOCK_DATA=1 to send its requests to this server instead of GitHub.
+With --record, requests without a fixture are sent to GitHub and their responses are saved as fixtures.`,
+		data.MockDataHost),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fixturesDir, _ := cmd.Flags().GetString("fixtures")
+		record, _ := cmd.Flags().GetBool("record")
+		addr, _ := cmd.Flags().GetString("addr")
+
+		opts := mockserver.Options{FixturesDir: fixturesDir, Record: record}
+		if record {
+			upstreamClient, err := api.DefaultHTTPClient()
+			if err != nil {
+				return err
+			}
+			opts.UpstreamClient = upstreamClient
+		}
+
+		server, err := mockserver.New(opts)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Serving fixtures from %s on https://%s\n", fixturesDir, addr)
+		return mockserver.ListenAndServeTLS(addr, server)
+	},
+}
+
+func init() {
+	mockServerCmd.Flags().String("fixtures", "testdata/mock", "directory to read and record fixtures in")
+	mockServerCmd.Flags().Bool("record", false, "record the responses of requests without a fixture from GitHub")
+	mockServerCmd.Flags().String("addr", data.MockDataHost, "address to listen on")
+	rootCmd.AddCommand(mockServerCmd)
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	slog "log"
 	"os"
+	"regexp"
 	"runtime"
 	"runtime/debug"
 	"runtime/pprof"
+	"slices"
 	"sync"
 	"time"
 
@@ -43,7 +45,7 @@ var (
 	logo = lipgloss.NewStyle().Foreground(dctx.LogoColor).MarginBottom(1).SetString(constants.Logo)
 
 	rootCmd = &cobra.Command{
-		Use: "gh dash",
+		Use: "gh dash [pr-or-issue]",
 		Long: lipgloss.JoinVertical(
 			lipgloss.Left,
 			logo.Render(),
@@ -65,6 +67,14 @@ gh dash
 # Run with a specific configuration file
 gh dash --config /path/to/configuration/file.yml
 
+# Open a PR or an issue in the preview, by URL or reference
+gh dash https://github.com/dlvhdr/gh-dash/pull/123
+gh dash dlvhdr/gh-dash#123
+gh dash '#123'
+
+# Start on the second section of the issues view, scoped to a repository
+gh dash --view issues --section 2 --repo dlvhdr/gh-dash
+
 # Run with debug logging to debug.log
 gh dash --debug
 
@@ -204,6 +214,25 @@ func init() {
 		"passing this flag will allow writing debug output to debug.log",
 	)
 
+	rootCmd.Flags().String(
+		"view",
+		"",
+		"view to start on, overriding defaults.view (prs, issues, notifications, discussions or repo)",
+	)
+
+	rootCmd.Flags().String(
+		"section",
+		"",
+		"section to start on, by title or 1-based index",
+	)
+
+	rootCmd.Flags().StringP(
+		"repo",
+		"R",
+		"",
+		"scope all sections to a repository, in the owner/repo format",
+	)
+
 	rootCmd.Flags().String(
 		"cpuprofile",
 		"",
@@ -217,7 +246,7 @@ func init() {
 		"help for gh-dash",
 	)
 
-	rootCmd.Run = func(_ *cobra.Command, args []string) {
+	rootCmd.RunE = func(_ *cobra.Command, args []string) error {
 		var gitRepoPath string
 		gitRepo, ghRepo, err := getCurrentGitAndGitHubRepos()
 		if err != nil {
@@ -261,6 +290,24 @@ func init() {
 			defer logger.Close()
 		}
 
+		launchOptions, err := parseLaunchFlags(rootCmd)
+		if err != nil {
+			return err
+		}
+		model.SetLaunchOptions(launchOptions)
+
+		if len(args) == 1 {
+			link, err := tui.ParseDeepLink(args[0], ghRepo)
+			if err != nil {
+				return err
+			}
+			row, err := tui.FetchDeepLink(link)
+			if err != nil {
+				return fmt.Errorf("failed fetching %s: %w", link, err)
+			}
+			model.SetDeepLinkRow(row)
+		}
+
 		cpuprofile, err := rootCmd.Flags().GetString("cpuprofile")
 		if err != nil {
 			log.Fatal("Cannot parse cpuprofile flag", err)
@@ -279,6 +326,8 @@ func init() {
 			fmt.Printf("%+v\n", err)
 			log.Fatal("fatal error during run", "err", err)
 		}
+
+		return nil
 	}
 }
 
@@ -312,3 +361,41 @@ func getCurrentGitAndGitHubRepos() (*gitm.Repository, repository.Repository, err
 	}
 	return gitRepo, ghRepo, gitErr
 }
+
+var repoFlagRegex = regexp.MustCompile(`^[\w.-]+/[\w.-]+$`)
+
+func parseLaunchFlags(cmd *cobra.Command) (tui.LaunchOptions, error) {
+	view, err := cmd.Flags().GetString("view")
+	if err != nil {
+		return tui.LaunchOptions{}, err
+	}
+	section, err := cmd.Flags().GetString("section")
+	if err != nil {
+		return tui.LaunchOptions{}, err
+	}
+	repo, err := cmd.Flags().GetString("repo")
+	if err != nil {
+		return tui.LaunchOptions{}, err
+	}
+
+	views := []config.ViewType{
+		config.PRsView,
+		config.IssuesView,
+		config.NotificationsView,
+		config.DiscussionsView,
+		config.RepoView,
+	}
+	if view != "" && !slices.Contains(views, config.ViewType(view)) {
+		return tui.LaunchOptions{}, fmt.Errorf(
+			"invalid view %q, expected one of prs, issues, notifications, discussions or repo", view)
+	}
+	if repo != "" && !repoFlagRegex.MatchString(repo) {
+		return tui.LaunchOptions{}, fmt.Errorf("invalid repo %q, expected owner/repo", repo)
+	}
+
+	return tui.LaunchOptions{
+		View:    config.ViewType(view),
+		Section: section,
+		Repo:    repo,
+	}, nil
+}
@@ -73,6 +73,7 @@ export default defineConfig({
             "configuration/searching",
             "configuration/pr-section",
             "configuration/issue-section",
+            "configuration/discussion-section",
             "configuration/notification-section",
             "configuration/repo-paths",
             "configuration/keybindings",
@@ -60,6 +60,16 @@ export function GET() {
             },
           ],
         },
+        discussionsSections: {
+          title: "Discussion Sections",
+          description:
+            "Define sections for the dashboard's Discussions view. The view is only shown when at least one section is defined.",
+          type: "array",
+          items: {
+            $ref: "./schema/discussion-section.json",
+          },
+          default: [],
+        },
         defaults: {
           $ref: "./schema/defaults.json",
         },
@@ -91,6 +101,22 @@ export function GET() {
             },
           },
         },
+        mergeMethods: {
+          title: "Merge Method Map",
+          description:
+            "Key-value pairs that match repositories to the merge method selected by default when merging their PRs.",
+          type: "object",
+          examples: [
+            {
+              "dlvhdr/*": "squash",
+              "dlvhdr/gh-dash": "rebase",
+            },
+          ],
+          additionalProperties: {
+            type: "string",
+            enum: ["merge", "squash", "rebase"],
+          },
+        },
         keybindings: {
           title: "Keybindings",
           description: "Define keybindings to run shell commands.",
@@ -102,6 +128,9 @@ export function GET() {
             issues: {
               $ref: "./schema/keybindings/issues.json",
             },
+            discussions: {
+              $ref: "./schema/keybindings/discussions.json",
+            },
             completions: {
               $ref: "./schema/keybindings/completions.json",
             },
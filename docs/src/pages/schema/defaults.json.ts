@@ -14,6 +14,7 @@ export function GET() {
         prsLimit: 20,
         prApproveComment: "LGTM",
         issuesLimit: 20,
+        discussionsLimit: 20,
         view: "prs",
         refetchIntervalMinutes: 30,
       },
@@ -48,6 +49,14 @@ export function GET() {
           minimum: 1,
           default: 20,
         },
+        discussionsLimit: {
+          title: "Discussion Fetch Limit",
+          description:
+            "Global limit on the number of discussions fetched for the dashboard",
+          type: "integer",
+          minimum: 1,
+          default: 20,
+        },
         preview: {
           title: "Preview Pane",
           description: "Defaults for the preview pane",
@@ -99,6 +108,21 @@ export function GET() {
           type: "string",
           default: "LGTM",
         },
+        mergeMethod: {
+          title: "Merge Method",
+          description: "The merge method selected by default when merging a PR.",
+          type: "string",
+          enum: ["merge", "squash", "rebase"],
+          default: "merge",
+        },
+        notifyMethod: {
+          title: "Notify Method",
+          description:
+            "How PR sections show the notifications raised when their PRs change.",
+          type: "string",
+          enum: ["desktop", "bell", "osc9", "osc777"],
+          default: "desktop",
+        },
       },
     }),
   );
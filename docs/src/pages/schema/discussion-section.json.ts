@@ -0,0 +1,38 @@
+export function GET() {
+  return new Response(
+    JSON.stringify({
+      $schema: "https://json-schema.org/draft/2020-12/schema",
+      $id: "discussion-section.schema.json",
+      title: "Discussion Section Options",
+      description: "Defines a section in the dashboard's Discussions view.",
+      type: "object",
+      required: ["title", "filters"],
+      properties: {
+        title: {
+          title: "Discussion Title",
+          description:
+            "Defines the section's name as displayed in the tabs for the discussions view.",
+          type: "string",
+        },
+        filters: {
+          title: "Discussion Filters",
+          description:
+            "Defines the GitHub search filters for the discussions in the section's table.",
+          type: "string",
+        },
+        limit: {
+          title: "Discussion Fetch Limit",
+          type: "integer",
+          minimum: 1,
+        },
+        host: {
+          title: "Discussion Host",
+          description:
+            "Defines the GitHub host to fetch the section from, e.g. a GitHub Enterprise Server hostname. Defaults to the host gh is logged into.",
+          type: "string",
+          format: "hostname",
+        },
+      },
+    }),
+  );
+}
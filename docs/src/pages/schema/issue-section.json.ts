@@ -26,6 +26,31 @@ export function GET() {
           type: "integer",
           minimum: 1,
         },
+        sort: {
+          title: "Issue Sort",
+          description:
+            "Lists the keys to sort the section's issues by, the first key being the primary one.",
+          type: "array",
+          items: {
+            type: "string",
+            enum: ["updated", "created", "comments", "repo", "author"],
+          },
+          default: [],
+        },
+        groupBy: {
+          title: "Issue Group By",
+          description:
+            "Groups the section's issues under a foldable header per group.",
+          type: "string",
+          enum: ["repo", "author", "label"],
+        },
+        host: {
+          title: "Issue Host",
+          description:
+            "Defines the GitHub host to fetch the section from, e.g. a GitHub Enterprise Server hostname. Defaults to the host gh is logged into.",
+          type: "string",
+          format: "hostname",
+        },
       },
     }),
   );
@@ -0,0 +1,14 @@
+export function GET() {
+  return new Response(
+    JSON.stringify({
+      $schema: "https://json-schema.org/draft/2020-12/schema",
+      $id: "discussions.schema.json",
+      title: "Discussions Commands",
+      description: "Keybindings for the Discussions View",
+      type: "array",
+      items: {
+        $ref: "./entry.json",
+      },
+    }),
+  );
+}
@@ -30,6 +30,69 @@ export function GET() {
             "One of gh-dash's builtin commands that will run when you press the key combination",
           type: "string",
         },
+        output: {
+          title: "Command Output",
+          description:
+            "Run the command in the background and capture its output. `pane` streams it to a scrollable pane in the preview area, `notify` shows its last line in the footer and `ignore` only reports failures.",
+          type: "string",
+          enum: ["pane", "notify", "ignore"],
+        },
+        interactive: {
+          title: "Interactive Command",
+          description:
+            "Hand the terminal to the command while it runs. This is the default when `output` isn't set, and can't be combined with it.",
+          type: "boolean",
+        },
+        prompts: {
+          title: "Command Prompts",
+          description:
+            "Values to ask for before the command runs. Each answer is passed to the command template as `{{.<name>}}`.",
+          type: "array",
+          items: {
+            type: "object",
+            required: ["name"],
+            properties: {
+              name: {
+                title: "Prompt Name",
+                description:
+                  "The name of the template variable the answer is passed as.",
+                type: "string",
+                pattern: "^[a-zA-Z0-9]+$",
+              },
+              title: {
+                title: "Prompt Title",
+                description:
+                  "The text shown when asking for the value. Defaults to the name.",
+                type: "string",
+              },
+              type: {
+                title: "Prompt Type",
+                description:
+                  "How the value is entered: free `text`, one of the `options` with `choice`, or a fuzzy pick from the repo's labels with `label` or its users with `user`.",
+                type: "string",
+                enum: ["text", "choice", "label", "user"],
+                default: "text",
+              },
+              options: {
+                title: "Prompt Options",
+                description: "The values to choose from in a `choice` prompt.",
+                type: "array",
+                items: { type: "string" },
+              },
+              default: {
+                title: "Default Value",
+                description: "The value the input starts with.",
+                type: "string",
+              },
+            },
+          },
+        },
+        confirm: {
+          title: "Confirmation",
+          description:
+            "A question that must be answered with `y` before the command runs. It's a template, with the same variables as the command.",
+          type: "string",
+        },
       },
     }),
   );
@@ -29,6 +29,51 @@ export function GET() {
           type: "integer",
           minimum: 1,
         },
+        notify: {
+          title: "PR Notifications",
+          description:
+            "Lists the changes of the section's PRs between fetches to notify about.",
+          type: "array",
+          items: {
+            type: "string",
+            enum: ["newPr", "reviewRequested", "checksFailed", "mergeable"],
+          },
+          default: [],
+        },
+        sort: {
+          title: "PR Sort",
+          description:
+            "Lists the keys to sort the section's PRs by, the first key being the primary one.",
+          type: "array",
+          items: {
+            type: "string",
+            enum: [
+              "updated",
+              "created",
+              "checks",
+              "reviewDecision",
+              "linesChanged",
+              "comments",
+              "repo",
+              "author",
+            ],
+          },
+          default: [],
+        },
+        groupBy: {
+          title: "PR Group By",
+          description:
+            "Groups the section's PRs under a foldable header per group.",
+          type: "string",
+          enum: ["repo", "author", "label", "baseBranch", "reviewStatus"],
+        },
+        host: {
+          title: "PR Host",
+          description:
+            "Defines the GitHub host to fetch the section from, e.g. a GitHub Enterprise Server hostname. Defaults to the host gh is logged into.",
+          type: "string",
+          format: "hostname",
+        },
       },
     }),
   );
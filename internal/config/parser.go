@@ -69,44 +69,94 @@ func (a *ViewType) UnmarshalJSON(b []byte) error {
 		*a = IssuesView
 	case "repo":
 		*a = RepoView
+	case "discussions":
+		*a = DiscussionsView
 	}
 
 	return nil
 }
 
+// SortKey is a field PR and issue sections can sort their rows by, on top of
+// the order of the search results
+type SortKey string
+
+const (
+	SortByUpdated        SortKey = "updated"
+	SortByCreated        SortKey = "created"
+	SortByChecks         SortKey = "checks"
+	SortByReviewDecision SortKey = "reviewDecision"
+	SortByLinesChanged   SortKey = "linesChanged"
+	SortByComments       SortKey = "comments"
+	SortByRepo           SortKey = "repo"
+	SortByAuthor         SortKey = "author"
+)
+
+// GroupKey is a field sections can group their rows by, each group shown
+// under a header that can be folded
+type GroupKey string
+
+const (
+	GroupByRepo         GroupKey = "repo"
+	GroupByAuthor       GroupKey = "author"
+	GroupByLabel        GroupKey = "label"
+	GroupByBaseBranch   GroupKey = "baseBranch"
+	GroupByReviewStatus GroupKey = "reviewStatus"
+	GroupByReason       GroupKey = "reason"
+)
+
 const (
 	NotificationsView ViewType = "notifications"
 	PRsView           ViewType = "prs"
 	IssuesView        ViewType = "issues"
 	RepoView          ViewType = "repo"
+	DiscussionsView   ViewType = "discussions"
 )
 
 type SectionConfig struct {
 	Title   string
 	Filters string
 	Limit   *int      `yaml:"limit,omitempty"`
+	Host    string    `yaml:"host,omitempty"`
 	Type    *ViewType `yaml:"type,omitempty"`
+	Sort    []SortKey `yaml:"sort,omitempty"`
+	GroupBy GroupKey  `yaml:"groupBy,omitempty"`
 }
 
 type PrsSectionConfig struct {
 	Title   string
 	Filters string
 	Limit   *int            `yaml:"limit,omitempty"`
-	Layout  PrsLayoutConfig `yaml:"layout,omitempty"`
+	Host    string          `yaml:"host,omitempty"    validate:"omitempty,hostname_rfc1123"`
+	Layout  PrsLayoutConfig `yaml:"layout,omitempty"  validate:"-"`
 	Type    *ViewType       `yaml:"type,omitempty"`
+	Notify  []NotifyEvent   `yaml:"notify,omitempty"  validate:"dive,oneof=newPr reviewRequested checksFailed mergeable"`
+	Sort    []SortKey       `yaml:"sort,omitempty"    validate:"dive,oneof=updated created checks reviewDecision linesChanged comments repo author"`
+	GroupBy GroupKey        `yaml:"groupBy,omitempty" validate:"omitempty,oneof=repo author label baseBranch reviewStatus"`
 }
 
 type IssuesSectionConfig struct {
 	Title   string
 	Filters string
 	Limit   *int               `yaml:"limit,omitempty"`
-	Layout  IssuesLayoutConfig `yaml:"layout,omitempty"`
+	Host    string             `yaml:"host,omitempty"    validate:"omitempty,hostname_rfc1123"`
+	Layout  IssuesLayoutConfig `yaml:"layout,omitempty"  validate:"-"`
+	Sort    []SortKey          `yaml:"sort,omitempty"    validate:"dive,oneof=updated created comments repo author"`
+	GroupBy GroupKey           `yaml:"groupBy,omitempty" validate:"omitempty,oneof=repo author label"`
+}
+
+type DiscussionsSectionConfig struct {
+	Title   string
+	Filters string
+	Limit   *int   `yaml:"limit,omitempty"`
+	Host    string `yaml:"host,omitempty" validate:"omitempty,hostname_rfc1123"`
 }
 
 type NotificationsSectionConfig struct {
 	Title   string
 	Filters string
-	Limit   *int `yaml:"limit,omitempty"`
+	Limit   *int     `yaml:"limit,omitempty"`
+	Host    string   `yaml:"host,omitempty"    validate:"omitempty,hostname_rfc1123"`
+	GroupBy GroupKey `yaml:"groupBy,omitempty" validate:"omitempty,oneof=repo reason"`
 }
 
 type PreviewConfig struct {
@@ -185,16 +235,47 @@ type LayoutConfig struct {
 	Issues IssuesLayoutConfig `yaml:"issues,omitempty"`
 }
 
+type MergeMethod string
+
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodSquash MergeMethod = "squash"
+	MergeMethodRebase MergeMethod = "rebase"
+)
+
+// NotifyEvent is a change of the PRs of a section, between two fetches, that
+// the section can notify about
+type NotifyEvent string
+
+const (
+	NotifyEventNewPr           NotifyEvent = "newPr"
+	NotifyEventReviewRequested NotifyEvent = "reviewRequested"
+	NotifyEventChecksFailed    NotifyEvent = "checksFailed"
+	NotifyEventMergeable       NotifyEvent = "mergeable"
+)
+
+type NotifyMethod string
+
+const (
+	NotifyMethodDesktop NotifyMethod = "desktop"
+	NotifyMethodBell    NotifyMethod = "bell"
+	NotifyMethodOsc9    NotifyMethod = "osc9"
+	NotifyMethodOsc777  NotifyMethod = "osc777"
+)
+
 type Defaults struct {
 	Preview                PreviewConfig `yaml:"preview"`
 	PrsLimit               int           `yaml:"prsLimit"`
 	PrApproveComment       string        `yaml:"prApproveComment,omitempty"`
 	IssuesLimit            int           `yaml:"issuesLimit"`
 	NotificationsLimit     int           `yaml:"notificationsLimit"`
+	DiscussionsLimit       int           `yaml:"discussionsLimit"`
 	View                   ViewType      `yaml:"view"`
 	Layout                 LayoutConfig  `yaml:"layout,omitempty"`
 	RefetchIntervalMinutes int           `yaml:"refetchIntervalMinutes,omitempty"`
 	DateFormat             string        `yaml:"dateFormat,omitempty"`
+	MergeMethod            MergeMethod   `yaml:"mergeMethod,omitempty"            validate:"omitempty,oneof=merge squash rebase"`
+	NotifyMethod           NotifyMethod  `yaml:"notifyMethod,omitempty"           validate:"omitempty,oneof=desktop bell osc9 osc777"`
 }
 
 type RepoConfig struct {
@@ -202,11 +283,62 @@ type RepoConfig struct {
 	PrsRefetchIntervalSeconds      int `yaml:"prsRefetchIntervalSeconds,omitempty"`
 }
 
+// CommandOutput is where the output of a custom command goes
+type CommandOutput string
+
+const (
+	// OutputPane streams the output to a scrollable pane in the preview area
+	OutputPane CommandOutput = "pane"
+	// OutputNotify shows the last line of the output in the footer
+	OutputNotify CommandOutput = "notify"
+	// OutputIgnore runs the command in the background and drops its output
+	OutputIgnore CommandOutput = "ignore"
+)
+
+// PromptType is how the answer to a keybinding prompt is entered
+type PromptType string
+
+const (
+	// PromptText is free text
+	PromptText PromptType = "text"
+	// PromptChoice is one of the prompt's options
+	PromptChoice PromptType = "choice"
+	// PromptLabel is one of the labels of the row's repo
+	PromptLabel PromptType = "label"
+	// PromptUser is one of the users that can be mentioned in the row's repo
+	PromptUser PromptType = "user"
+)
+
+// KeybindingPrompt asks for a value before running a keybinding's command.
+// The answer is passed to the command template as {{.<Name>}}.
+type KeybindingPrompt struct {
+	Name    string     `yaml:"name"              validate:"required,alphanum"`
+	Title   string     `yaml:"title,omitempty"`
+	Type    PromptType `yaml:"type,omitempty"    validate:"omitempty,oneof=text choice label user"`
+	Options []string   `yaml:"options,omitempty" validate:"required_if=Type choice"`
+	Default string     `yaml:"default,omitempty"`
+}
+
 type Keybinding struct {
 	Key     string `yaml:"key"`
 	Command string `yaml:"command,omitempty"`
 	Builtin string `yaml:"builtin,omitempty"`
 	Name    string `yaml:"name,omitempty"`
+	// Output captures the output of the command instead of handing it the
+	// terminal
+	Output      CommandOutput `yaml:"output,omitempty"      validate:"omitempty,oneof=pane notify ignore"`
+	Interactive bool          `yaml:"interactive,omitempty" validate:"excluded_with=Output"`
+	// Prompts are asked in order before the command runs
+	Prompts []KeybindingPrompt `yaml:"prompts,omitempty" validate:"dive"`
+	// Confirm is a template for a question that must be answered with "y"
+	// before the command runs
+	Confirm string `yaml:"confirm,omitempty"`
+}
+
+// IsInteractive reports whether the command takes over the terminal while it
+// runs, which is the default unless its output is captured
+func (kb Keybinding) IsInteractive() bool {
+	return kb.Interactive || kb.Output == ""
 }
 
 func (kb Keybinding) NewBinding(previous *key.Binding) key.Binding {
@@ -226,12 +358,13 @@ func (kb Keybinding) NewBinding(previous *key.Binding) key.Binding {
 }
 
 type Keybindings struct {
-	Universal     []Keybinding `yaml:"universal,omitempty"`
-	Issues        []Keybinding `yaml:"issues,omitempty"`
-	Prs           []Keybinding `yaml:"prs,omitempty"`
-	Branches      []Keybinding `yaml:"branches,omitempty"`
-	Notifications []Keybinding `yaml:"notifications,omitempty"`
-	Cmp           []Keybinding `yaml:"completions,omitempty"`
+	Universal     []Keybinding `yaml:"universal,omitempty"     validate:"dive"`
+	Issues        []Keybinding `yaml:"issues,omitempty"        validate:"dive"`
+	Prs           []Keybinding `yaml:"prs,omitempty"           validate:"dive"`
+	Branches      []Keybinding `yaml:"branches,omitempty"      validate:"dive"`
+	Notifications []Keybinding `yaml:"notifications,omitempty" validate:"dive"`
+	Discussions   []Keybinding `yaml:"discussions,omitempty"   validate:"dive"`
+	Cmp           []Keybinding `yaml:"completions,omitempty"   validate:"dive"`
 }
 
 type Pager struct {
@@ -320,13 +453,15 @@ type ThemeConfig struct {
 
 type Config struct {
 	Include                  []string                     `yaml:"include,omitempty"`
-	PRSections               []PrsSectionConfig           `yaml:"prSections"`
-	IssuesSections           []IssuesSectionConfig        `yaml:"issuesSections"`
-	NotificationsSections    []NotificationsSectionConfig `yaml:"notificationsSections"`
+	PRSections               []PrsSectionConfig           `yaml:"prSections"                validate:"dive"`
+	IssuesSections           []IssuesSectionConfig        `yaml:"issuesSections"            validate:"dive"`
+	NotificationsSections    []NotificationsSectionConfig `yaml:"notificationsSections"     validate:"dive"`
+	DiscussionsSections      []DiscussionsSectionConfig   `yaml:"discussionsSections,omitempty"`
 	Repo                     RepoConfig                   `yaml:"repo,omitempty"`
 	Defaults                 Defaults                     `yaml:"defaults"`
 	Keybindings              Keybindings                  `yaml:"keybindings"`
 	RepoPaths                map[string]string            `yaml:"repoPaths"`
+	MergeMethods             map[string]MergeMethod       `yaml:"mergeMethods,omitempty"     validate:"dive,oneof=merge squash rebase"`
 	Theme                    *ThemeConfig                 `yaml:"theme,omitempty"           validate:"omitempty"`
 	Pager                    Pager                        `yaml:"pager"`
 	ConfirmQuit              bool                         `yaml:"confirmQuit"`
@@ -358,6 +493,7 @@ func (parser ConfigParser) getDefaultConfig() Config {
 			PrApproveComment:       "LGTM",
 			IssuesLimit:            20,
 			NotificationsLimit:     20,
+			DiscussionsLimit:       20,
 			View:                   PRsView,
 			RefetchIntervalMinutes: 30,
 			Layout: LayoutConfig{
@@ -634,10 +770,15 @@ func (parser ConfigParser) getProvidedConfigPath(location Location) string {
 
 // keybindingTypes are the keybinding groups that are unioned across config
 // layers rather than being replaced wholesale.
-var keybindingTypes = []string{"universal", "prs", "issues", "completions"}
+var keybindingTypes = []string{"universal", "prs", "issues", "discussions", "completions"}
 
 // sectionTypes are replaced wholesale by any layer that defines them.
-var sectionTypes = []string{"prSections", "issuesSections", "notificationsSections"}
+var sectionTypes = []string{
+	"prSections",
+	"issuesSections",
+	"notificationsSections",
+	"discussionsSections",
+}
 
 func mergeOption() koanf.Option {
 	return koanf.WithMergeFunc(func(overrides, dest map[string]any) error {
@@ -645,7 +786,7 @@ func mergeOption() koanf.Option {
 		// below can tell which sections this layer actually declared.
 		overridesCopy := maps.Copy(overrides)
 
-		unioned := make(map[string][]map[string]string, len(keybindingTypes))
+		unioned := make(map[string][]map[string]any, len(keybindingTypes))
 		for _, typ := range keybindingTypes {
 			unioned[typ] = mergeKeybindings(overrides, dest, typ)
 		}
@@ -691,38 +832,47 @@ func resolveIncludePath(includingCfgPath, includePath string) string {
 }
 
 func (parser ConfigParser) loadConfig(cfgPath string) error {
-	return parser.loadConfigWithIncludes(cfgPath, map[string]bool{})
+	layers, err := parser.configLayers(cfgPath, map[string]bool{})
+	if err != nil {
+		return err
+	}
+	for _, layer := range layers {
+		if err := parser.k.Load(file.Provider(layer), yaml.Parser(), mergeOption()); err != nil {
+			return parsingError{err: err, path: layer}
+		}
+		log.Info("Loaded config", "path", layer)
+	}
+	return nil
 }
 
-// seen holds absolute paths already loaded, so an include cycle can't loop forever.
-func (parser ConfigParser) loadConfigWithIncludes(cfgPath string, seen map[string]bool) error {
+// configLayers returns the files cfgPath is loaded from, in order: its
+// includes first so cfgPath's own values take precedence over them, then
+// cfgPath itself. seen holds absolute paths already visited, so an include
+// cycle can't loop forever.
+func (parser ConfigParser) configLayers(cfgPath string, seen map[string]bool) ([]string, error) {
 	abs, err := filepath.Abs(cfgPath)
 	if err != nil {
 		abs = cfgPath
 	}
 	if seen[abs] {
 		log.Warn("Skipping already-included config to avoid a cycle", "path", cfgPath)
-		return nil
+		return nil, nil
 	}
 	seen[abs] = true
 
 	includes, err := parser.getIncludes(cfgPath)
 	if err != nil {
-		return parsingError{err: err, path: cfgPath}
+		return nil, parsingError{err: err, path: cfgPath}
 	}
-	// Load includes first so cfgPath's own values take precedence over them.
+	var layers []string
 	for _, include := range includes {
-		includePath := resolveIncludePath(cfgPath, include)
-		if err := parser.loadConfigWithIncludes(includePath, seen); err != nil {
-			return err
+		includeLayers, err := parser.configLayers(resolveIncludePath(cfgPath, include), seen)
+		if err != nil {
+			return nil, err
 		}
+		layers = append(layers, includeLayers...)
 	}
-
-	if err := parser.k.Load(file.Provider(cfgPath), yaml.Parser(), mergeOption()); err != nil {
-		return parsingError{err: err, path: cfgPath}
-	}
-	log.Info("Loaded config", "path", cfgPath)
-	return nil
+	return append(layers, cfgPath), nil
 }
 
 func (parser ConfigParser) mergeConfigs(globalCfgPath, userProvidedCfgPath string) (Config, error) {
@@ -735,49 +885,41 @@ func (parser ConfigParser) mergeConfigs(globalCfgPath, userProvidedCfgPath strin
 	return parser.unmarshalConfigWithDefaults()
 }
 
-func mergeKeybindings(overrides, dest map[string]any, typ string) []map[string]string {
-	byKey := make(map[string]map[string]string)
+func mergeKeybindings(overrides, dest map[string]any, typ string) []map[string]any {
+	byKey := make(map[string]map[string]any)
 	// dest first, then overrides, so a key bound in both resolves to overrides.
 	for _, layer := range []map[string]any{dest, overrides} {
 		for _, keybind := range keybindingsByType(layer, typ) {
-			if key, ok := keybind["key"]; ok {
+			if key, ok := keybind["key"].(string); ok {
 				byKey[key] = keybind
 			}
 		}
 	}
 
-	merged := make([]map[string]string, 0, len(byKey))
+	merged := make([]map[string]any, 0, len(byKey))
 	for _, keybind := range byKey {
 		merged = append(merged, keybind)
 	}
 	return merged
 }
 
-func keybindingsByType(layer map[string]any, typ string) []map[string]string {
+func keybindingsByType(layer map[string]any, typ string) []map[string]any {
 	keybindings, ok := layer["keybindings"].(map[string]any)
 	if !ok {
 		return nil
 	}
 
 	// Raw YAML parses to []any, but a previous merge pass writes the type back as
-	// []map[string]string, so both shapes can show up once 3+ layers are merged.
+	// []map[string]any, so both shapes can show up once 3+ layers are merged.
 	switch list := keybindings[typ].(type) {
-	case []map[string]string:
+	case []map[string]any:
 		return list
 	case []any:
-		out := make([]map[string]string, 0, len(list))
+		out := make([]map[string]any, 0, len(list))
 		for _, item := range list {
-			m, ok := item.(map[string]any)
-			if !ok {
-				continue
-			}
-			casted := make(map[string]string)
-			for key, val := range m {
-				if s, ok := val.(string); ok {
-					casted[key] = s
-				}
+			if m, ok := item.(map[string]any); ok {
+				out = append(out, m)
 			}
-			out = append(out, casted)
 		}
 		return out
 	default:
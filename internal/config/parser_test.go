@@ -184,6 +184,101 @@ func TestParser(t *testing.T) {
 			keys = append(keys, kb.Key)
 		}
 		require.ElementsMatch(t, []string{"a", "b", "c"}, keys)
+
+		// Non-string options survive the union too
+		for _, kb := range parsed.Keybindings.Universal {
+			switch kb.Key {
+			case "c":
+				require.Equal(t, "Echo {{.Word}}?", kb.Confirm)
+				require.Equal(t, []KeybindingPrompt{{
+					Name:    "Word",
+					Type:    PromptChoice,
+					Options: []string{"foo", "bar"},
+				}}, kb.Prompts)
+			}
+		}
+	})
+
+	t.Run("Should keep the output options of included keybindings", func(t *testing.T) {
+		cwd := Testwd(t)
+		parsed, err := ParseConfig(Location{
+			ConfigFlag:       path.Join(cwd, "testdata/include-output-main.yml"),
+			SkipGlobalConfig: true,
+		})
+		testutils.AssertNoError(t, err)
+
+		require.Len(t, parsed.Keybindings.Universal, 2)
+		for _, kb := range parsed.Keybindings.Universal {
+			switch kb.Key {
+			case "i":
+				require.True(t, kb.Interactive)
+			case "o":
+				require.Equal(t, OutputPane, kb.Output)
+				require.False(t, kb.IsInteractive())
+			default:
+				t.Fatalf("unexpected keybinding %q", kb.Key)
+			}
+		}
+	})
+
+	t.Run("Should reject a choice prompt without options", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := path.Join(dir, "config.yml")
+		err := os.WriteFile(configPath, []byte(`keybindings:
+  prs:
+    - key: B
+      command: backport {{.PrNumber}} {{.Branch}}
+      prompts:
+        - name: Branch
+          type: choice
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		_, err = ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+		require.ErrorContains(t, err, "prompts[0].options")
+	})
+
+	t.Run("Should not validate the layout of sections", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := path.Join(dir, "config.yml")
+		err := os.WriteFile(configPath, []byte(`prSections:
+  - title: Mine
+    filters: author:@me
+    notify: [newPr]
+    layout:
+      repo:
+        width: 0
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		parsed, err := ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+		testutils.AssertNoError(t, err)
+		require.Equal(t, []NotifyEvent{NotifyEventNewPr}, parsed.PRSections[0].Notify)
+	})
+
+	t.Run("Should reject an interactive command with captured output", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := path.Join(dir, "config.yml")
+		err := os.WriteFile(configPath, []byte(`keybindings:
+  prs:
+    - key: D
+      command: deploy-status {{.PrNumber}}
+      output: pane
+      interactive: true
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		_, err = ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+		require.ErrorContains(t, err, "prs[0].interactive")
 	})
 
 	t.Run("Should accept ANSI color indices in theme", func(t *testing.T) {
@@ -198,6 +293,51 @@ func TestParser(t *testing.T) {
 		require.Equal(t, Color("013"), parsed.Theme.Colors.Inline.Border.Primary)
 		require.Equal(t, Color("008"), parsed.Theme.Colors.Inline.Background.Selected)
 	})
+
+	t.Run("Should read the host of sections", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := path.Join(dir, "config.yml")
+		err := os.WriteFile(configPath, []byte(`prSections:
+  - title: Work
+    filters: is:open review-requested:@me
+    host: ghe.example.com
+  - title: Mine
+    filters: is:open author:@me
+notificationsSections:
+  - title: Work
+    filters: ""
+    host: ghe.example.com
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		parsed, err := ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+
+		testutils.AssertNoError(t, err)
+		require.Equal(t, "ghe.example.com", parsed.PRSections[0].Host)
+		require.Equal(t, "ghe.example.com", parsed.PRSections[0].ToSectionConfig().Host)
+		require.Equal(t, "", parsed.PRSections[1].Host)
+		require.Equal(t, "ghe.example.com", parsed.NotificationsSections[0].ToSectionConfig().Host)
+	})
+
+	t.Run("Should reject a section host that isn't a hostname", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := path.Join(dir, "config.yml")
+		err := os.WriteFile(configPath, []byte(`issuesSections:
+  - title: Work
+    filters: is:open
+    host: https://ghe.example.com/
+`), 0o600)
+		testutils.AssertNoError(t, err)
+
+		_, err = ParseConfig(Location{
+			ConfigFlag:       configPath,
+			SkipGlobalConfig: true,
+		})
+		require.ErrorContains(t, err, "host")
+	})
 }
 
 func loadExpected(t *testing.T, fpath string) Config {
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+
+	"charm.land/log/v2"
+	yamlmarshaller "gopkg.in/yaml.v3"
+)
+
+// SavePrsSection appends a PR section to the config file defining the PR
+// sections and returns the path of the file
+func SavePrsSection(location Location, cfg *Config, section PrsSectionConfig) (string, error) {
+	return saveSection(location, "prSections", cfg.PRSections, section)
+}
+
+// SaveIssuesSection appends an issue section to the config file defining the
+// issue sections and returns the path of the file
+func SaveIssuesSection(
+	location Location,
+	cfg *Config,
+	section IssuesSectionConfig,
+) (string, error) {
+	return saveSection(location, "issuesSections", cfg.IssuesSections, section)
+}
+
+// SaveNotificationsSection appends a notification section to the config file
+// defining the notification sections and returns the path of the file
+func SaveNotificationsSection(
+	location Location,
+	cfg *Config,
+	section NotificationsSectionConfig,
+) (string, error) {
+	return saveSection(location, "notificationsSections", cfg.NotificationsSections, section)
+}
+
+// saveSection appends section to the list at key of the last file loaded
+// that defines it, as that list replaces the lists of the files loaded
+// before. When no file defines it, the list is added to the config file
+// itself along with the current sections, which would be the defaults, so
+// they aren't lost. The file is edited as YAML nodes to keep its comments.
+func saveSection[T any](location Location, key string, current []T, section T) (string, error) {
+	parser := initParser()
+	roots, err := parser.configRoots(location)
+	if err != nil {
+		return "", err
+	}
+
+	target := roots[len(roots)-1]
+	defined := false
+	for _, root := range roots {
+		layers, err := parser.configLayers(root, map[string]bool{})
+		if err != nil {
+			return "", err
+		}
+		for _, layer := range layers {
+			doc, err := readConfigNode(layer)
+			if err != nil {
+				return "", err
+			}
+			if mappingValue(doc, key) != nil {
+				target = layer
+				defined = true
+			}
+		}
+	}
+
+	doc, err := readConfigNode(target)
+	if err != nil {
+		return "", err
+	}
+	sections := []T{section}
+	if !defined {
+		sections = append(slices.Clone(current), section)
+	}
+	if err := appendToSequence(doc, key, sections); err != nil {
+		return "", parsingError{path: target, err: err}
+	}
+	if err := writeConfigNode(target, doc); err != nil {
+		return "", err
+	}
+	log.Info("Saved section", "key", key, "path", target)
+	return target, nil
+}
+
+// configRoots returns the config files loaded by ParseConfig, before their
+// includes, in the order they're loaded
+func (parser ConfigParser) configRoots(location Location) ([]string, error) {
+	userProvidedCfgPath := parser.getProvidedConfigPath(location)
+	if location.SkipGlobalConfig && userProvidedCfgPath != "" {
+		return []string{userProvidedCfgPath}, nil
+	}
+
+	globalCfgPath, err := parser.getGlobalConfigPathOrCreateIfMissing()
+	if err != nil {
+		return nil, parsingError{path: globalCfgPath, err: err}
+	}
+	if userProvidedCfgPath == "" {
+		return []string{globalCfgPath}, nil
+	}
+	return []string{globalCfgPath, userProvidedCfgPath}, nil
+}
+
+func readConfigNode(path string) (*yamlmarshaller.Node, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc yamlmarshaller.Node
+	if err := yamlmarshaller.Unmarshal(content, &doc); err != nil {
+		return nil, parsingError{path: path, err: err}
+	}
+	// An empty file has no document
+	if doc.Kind == 0 {
+		doc = yamlmarshaller.Node{
+			Kind:    yamlmarshaller.DocumentNode,
+			Content: []*yamlmarshaller.Node{{Kind: yamlmarshaller.MappingNode, Tag: "!!map"}},
+		}
+	}
+	return &doc, nil
+}
+
+func writeConfigNode(path string, doc *yamlmarshaller.Node) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	enc := yamlmarshaller.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), info.Mode().Perm())
+}
+
+// mappingValue returns the node of the top level key of the document, or nil
+// if it's not set
+func mappingValue(doc *yamlmarshaller.Node, key string) *yamlmarshaller.Node {
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yamlmarshaller.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			return root.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func appendToSequence[T any](doc *yamlmarshaller.Node, key string, items []T) error {
+	root := doc.Content[0]
+	if root.Kind != yamlmarshaller.MappingNode {
+		return fmt.Errorf("the config isn't a mapping")
+	}
+
+	seq := mappingValue(doc, key)
+	if seq == nil {
+		seq = &yamlmarshaller.Node{}
+		root.Content = append(root.Content,
+			&yamlmarshaller.Node{Kind: yamlmarshaller.ScalarNode, Tag: "!!str", Value: key}, seq)
+	}
+	switch {
+	case seq.Kind == yamlmarshaller.SequenceNode:
+		// Block items can't be added to a flow sequence, e.g. []
+		seq.Style &^= yamlmarshaller.FlowStyle
+	case seq.Kind == 0 || seq.Tag == "!!null":
+		*seq = yamlmarshaller.Node{
+			Kind:        yamlmarshaller.SequenceNode,
+			Tag:         "!!seq",
+			HeadComment: seq.HeadComment,
+			LineComment: seq.LineComment,
+			FootComment: seq.FootComment,
+		}
+	default:
+		return fmt.Errorf("%s isn't a list", key)
+	}
+
+	for _, item := range items {
+		var node yamlmarshaller.Node
+		if err := node.Encode(item); err != nil {
+			return err
+		}
+		seq.Content = append(seq.Content, &node)
+	}
+	return nil
+}
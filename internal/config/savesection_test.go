@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestConfig(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	cfgPath := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(cfgPath, []byte(content), 0o644))
+	return cfgPath
+}
+
+func TestSaveSection(t *testing.T) {
+	t.Run("Should append the section and keep the comments", func(t *testing.T) {
+		dir := t.TempDir()
+		cfgPath := writeTestConfig(t, dir, "config.yml", `# my dashboard
+prSections:
+  # mine
+  - title: Mine
+    filters: is:open author:@me
+`)
+		location := Location{ConfigFlag: cfgPath, SkipGlobalConfig: true}
+		cfg, err := ParseConfig(location)
+		require.NoError(t, err)
+
+		saved, err := SavePrsSection(location, &cfg, PrsSectionConfig{
+			Title:   "Bugs",
+			Filters: "is:open label:bug",
+		})
+		require.NoError(t, err)
+		require.Equal(t, cfgPath, saved)
+
+		content, err := os.ReadFile(cfgPath)
+		require.NoError(t, err)
+		require.Contains(t, string(content), "# my dashboard")
+		require.Contains(t, string(content), "# mine")
+
+		parsed, err := ParseConfig(location)
+		require.NoError(t, err)
+		require.Len(t, parsed.PRSections, 2)
+		require.Equal(t, "Bugs", parsed.PRSections[1].Title)
+		require.Equal(t, "is:open label:bug", parsed.PRSections[1].Filters)
+	})
+
+	t.Run("Should append to the included file defining the sections", func(t *testing.T) {
+		dir := t.TempDir()
+		basePath := writeTestConfig(t, dir, "base.yml", `issuesSections:
+  - title: Assigned
+    filters: is:open assignee:@me
+`)
+		cfgPath := writeTestConfig(t, dir, "config.yml", `include:
+  - base.yml
+defaults:
+  issuesLimit: 7
+`)
+		location := Location{ConfigFlag: cfgPath, SkipGlobalConfig: true}
+		cfg, err := ParseConfig(location)
+		require.NoError(t, err)
+
+		saved, err := SaveIssuesSection(location, &cfg, IssuesSectionConfig{
+			Title:   "Mentioned",
+			Filters: "is:open mentions:@me",
+		})
+		require.NoError(t, err)
+		require.Equal(t, basePath, saved)
+
+		parsed, err := ParseConfig(location)
+		require.NoError(t, err)
+		require.Len(t, parsed.IssuesSections, 2)
+		require.Equal(t, "Mentioned", parsed.IssuesSections[1].Title)
+	})
+
+	t.Run("Should keep the default sections when none are defined", func(t *testing.T) {
+		dir := t.TempDir()
+		cfgPath := writeTestConfig(t, dir, "config.yml", "")
+		location := Location{ConfigFlag: cfgPath, SkipGlobalConfig: true}
+		cfg, err := ParseConfig(location)
+		require.NoError(t, err)
+		defaults := len(cfg.NotificationsSections)
+
+		_, err = SaveNotificationsSection(location, &cfg, NotificationsSectionConfig{
+			Title:   "Reviews",
+			Filters: "reason:review-requested",
+		})
+		require.NoError(t, err)
+
+		parsed, err := ParseConfig(location)
+		require.NoError(t, err)
+		require.Len(t, parsed.NotificationsSections, defaults+1)
+		require.Equal(t, "Reviews", parsed.NotificationsSections[defaults].Title)
+	})
+}
@@ -28,12 +28,36 @@ func (cfg Config) GetFullScreenDiffPagerEnv() []string {
 	return env
 }
 
+// GetMergeMethod returns the merge method to use for the repo. Repos can be
+// matched exactly or with an "owner/*" wildcard, and fall back to the
+// default merge method.
+func (cfg Config) GetMergeMethod(repoName string) MergeMethod {
+	if method, ok := cfg.MergeMethods[repoName]; ok {
+		return method
+	}
+
+	owner, _, found := strings.Cut(repoName, "/")
+	if found {
+		if method, ok := cfg.MergeMethods[owner+"/*"]; ok {
+			return method
+		}
+	}
+
+	if cfg.Defaults.MergeMethod != "" {
+		return cfg.Defaults.MergeMethod
+	}
+	return MergeMethodMerge
+}
+
 func (cfg PrsSectionConfig) ToSectionConfig() SectionConfig {
 	return SectionConfig{
 		Title:   cfg.Title,
 		Filters: cfg.Filters,
 		Limit:   cfg.Limit,
+		Host:    cfg.Host,
 		Type:    cfg.Type,
+		Sort:    cfg.Sort,
+		GroupBy: cfg.GroupBy,
 	}
 }
 
@@ -42,6 +66,18 @@ func (cfg IssuesSectionConfig) ToSectionConfig() SectionConfig {
 		Title:   cfg.Title,
 		Filters: cfg.Filters,
 		Limit:   cfg.Limit,
+		Host:    cfg.Host,
+		Sort:    cfg.Sort,
+		GroupBy: cfg.GroupBy,
+	}
+}
+
+func (cfg DiscussionsSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:   cfg.Title,
+		Filters: cfg.Filters,
+		Limit:   cfg.Limit,
+		Host:    cfg.Host,
 	}
 }
 
@@ -50,6 +86,8 @@ func (cfg NotificationsSectionConfig) ToSectionConfig() SectionConfig {
 		Title:   cfg.Title,
 		Filters: cfg.Filters,
 		Limit:   cfg.Limit,
+		Host:    cfg.Host,
+		GroupBy: cfg.GroupBy,
 	}
 }
 
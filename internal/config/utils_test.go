@@ -0,0 +1,24 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMergeMethod(t *testing.T) {
+	cfg := Config{
+		Defaults: Defaults{MergeMethod: MergeMethodRebase},
+		MergeMethods: map[string]MergeMethod{
+			"dlvhdr/*":       MergeMethodSquash,
+			"dlvhdr/gh-dash": MergeMethodMerge,
+		},
+	}
+
+	require.Equal(t, MergeMethodMerge, cfg.GetMergeMethod("dlvhdr/gh-dash"))
+	require.Equal(t, MergeMethodSquash, cfg.GetMergeMethod("dlvhdr/jb"))
+	require.Equal(t, MergeMethodRebase, cfg.GetMergeMethod("charmbracelet/bubbletea"))
+
+	cfg.Defaults.MergeMethod = ""
+	require.Equal(t, MergeMethodMerge, cfg.GetMergeMethod("charmbracelet/bubbletea"))
+}
@@ -0,0 +1,181 @@
+package data
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"charm.land/log/v2"
+)
+
+// WorkflowJob is a job of a GitHub Actions workflow run, as returned by the
+// REST API
+type WorkflowJob struct {
+	Id         int64     `json:"id"`
+	RunId      int64     `json:"run_id"`
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`
+	Conclusion string    `json:"conclusion"`
+	Steps      []JobStep `json:"steps"`
+}
+
+type JobStep struct {
+	Name       string `json:"name"`
+	Number     int    `json:"number"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+}
+
+// FailedSteps returns the names of the steps of the job that failed
+func (j WorkflowJob) FailedSteps() []string {
+	var steps []string
+	for _, step := range j.Steps {
+		if step.Conclusion == "failure" || step.Conclusion == "timed_out" {
+			steps = append(steps, step.Name)
+		}
+	}
+	return steps
+}
+
+func FetchWorkflowJob(repoNameWithOwner string, jobId int64, host string) (WorkflowJob, error) {
+	client, err := restClientForHost(host)
+	if err != nil {
+		return WorkflowJob{}, err
+	}
+
+	var job WorkflowJob
+	log.Debug("Fetching workflow job", "repo", repoNameWithOwner, "job", jobId)
+	err = client.Get(fmt.Sprintf("repos/%s/actions/jobs/%d", repoNameWithOwner, jobId), &job)
+	return job, err
+}
+
+// FetchJobLog returns the last lines of the plain text log of a job, at most
+// tail of them, and how many lines came before them. The API redirects to the
+// log file which the client follows. Logs can be huge, so only the lines kept
+// are held in memory while reading it.
+func FetchJobLog(
+	repoNameWithOwner string,
+	jobId int64,
+	host string,
+	tail int,
+) (string, int, error) {
+	client, err := restClientForHost(host)
+	if err != nil {
+		return "", 0, err
+	}
+
+	log.Debug("Fetching job log", "repo", repoNameWithOwner, "job", jobId)
+	res, err := client.Request(
+		http.MethodGet,
+		fmt.Sprintf("repos/%s/actions/jobs/%d/logs", repoNameWithOwner, jobId),
+		nil,
+	)
+	if err != nil {
+		return "", 0, err
+	}
+	defer res.Body.Close()
+
+	lines, skipped, err := tailLines(res.Body, tail)
+	if err != nil {
+		return "", 0, err
+	}
+	return strings.Join(lines, "\n"), skipped, nil
+}
+
+// tailLines reads r and returns its last n lines and how many lines came
+// before them, keeping the lines in a ring while reading
+func tailLines(r io.Reader, n int) ([]string, int, error) {
+	n = max(1, n)
+	ring := make([]string, 0, n)
+	next, total := 0, 0
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			line = strings.TrimSuffix(line, "\n")
+			if len(ring) < n {
+				ring = append(ring, line)
+			} else {
+				ring[next] = line
+			}
+			next = (next + 1) % n
+			total++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if len(ring) < n {
+		return ring, 0, nil
+	}
+	return append(ring[next:], ring[:next]...), total - n, nil
+}
+
+// JobLogLine is a line of a job log without its timestamp
+type JobLogLine struct {
+	Text string
+	// IsGroup is true for lines starting a group, usually the start of a step
+	IsGroup bool
+	IsError bool
+	// InFailure is true for lines of the group the first error is in, which
+	// is usually the step that failed
+	InFailure bool
+}
+
+var logTimestampRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?Z ?`)
+
+// ParseJobLog splits a job log into lines, dropping the timestamps and the
+// workflow commands that only end groups
+func ParseJobLog(raw string) []JobLogLine {
+	raw = strings.TrimPrefix(raw, "\ufeff")
+	raw = strings.ReplaceAll(raw, "\r\n", "\n")
+
+	lines := make([]JobLogLine, 0)
+	firstError := -1
+	for _, text := range strings.Split(strings.TrimRight(raw, "\n"), "\n") {
+		text = logTimestampRegex.ReplaceAllString(text, "")
+		if strings.HasPrefix(text, "##[endgroup]") {
+			continue
+		}
+
+		line := JobLogLine{Text: text}
+		if rest, ok := strings.CutPrefix(text, "##[group]"); ok {
+			line.Text = rest
+			line.IsGroup = true
+		} else if rest, ok := strings.CutPrefix(text, "##[error]"); ok {
+			line.Text = rest
+			line.IsError = true
+			if firstError == -1 {
+				firstError = len(lines)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	if firstError == -1 {
+		return lines
+	}
+
+	start := 0
+	for i := firstError; i >= 0; i-- {
+		if lines[i].IsGroup {
+			start = i
+			break
+		}
+	}
+	for i := start; i < len(lines); i++ {
+		if i > firstError && lines[i].IsGroup {
+			break
+		}
+		lines[i].InFailure = true
+	}
+
+	return lines
+}
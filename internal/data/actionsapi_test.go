@@ -0,0 +1,56 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJobLog(t *testing.T) {
+	raw := "\ufeff2024-05-01T10:00:00.1234567Z ##[group]Run actions/checkout@v4\r\n" +
+		"2024-05-01T10:00:01.1234567Z Syncing repository\r\n" +
+		"2024-05-01T10:00:02.1234567Z ##[endgroup]\r\n" +
+		"2024-05-01T10:00:03.1234567Z ##[group]Run go test ./...\r\n" +
+		"2024-05-01T10:00:04.1234567Z --- FAIL: TestSomething\r\n" +
+		"2024-05-01T10:00:05.1234567Z ##[error]Process completed with exit code 1.\r\n" +
+		"2024-05-01T10:00:06.1234567Z ##[group]Post job cleanup.\r\n"
+
+	require.Equal(t, []JobLogLine{
+		{Text: "Run actions/checkout@v4", IsGroup: true},
+		{Text: "Syncing repository"},
+		{Text: "Run go test ./...", IsGroup: true, InFailure: true},
+		{Text: "--- FAIL: TestSomething", InFailure: true},
+		{Text: "Process completed with exit code 1.", IsError: true, InFailure: true},
+		{Text: "Post job cleanup.", IsGroup: true},
+	}, ParseJobLog(raw))
+}
+
+func TestParseJobLogWithoutErrors(t *testing.T) {
+	lines := ParseJobLog("2024-05-01T10:00:00Z hello\n")
+
+	require.Equal(t, []JobLogLine{{Text: "hello"}}, lines)
+}
+
+func TestWorkflowJobFailedSteps(t *testing.T) {
+	job := WorkflowJob{Steps: []JobStep{
+		{Name: "Set up job", Conclusion: "success"},
+		{Name: "Test", Conclusion: "failure"},
+		{Name: "Lint", Conclusion: "skipped"},
+	}}
+
+	require.Equal(t, []string{"Test"}, job.FailedSteps())
+}
+
+func TestTailLines(t *testing.T) {
+	lines, skipped, err := tailLines(strings.NewReader("1\n2\n3\n4\n5\n"), 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"3", "4", "5"}, lines)
+	require.Equal(t, 2, skipped)
+
+	// The last line may not end with a newline
+	lines, skipped, err = tailLines(strings.NewReader("1\n2"), 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, lines)
+	require.Zero(t, skipped)
+}
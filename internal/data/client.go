@@ -0,0 +1,143 @@
+package data
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/auth"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// MockDataHost is where the clients send their requests when FF_MOCK_DATA is
+// set. Run `gh dash mock-server` to serve the recorded fixtures there.
+const MockDataHost = "localhost:3000"
+
+// The clients of hosts other than the default one, created on first use
+var (
+	hostClientsMu      sync.Mutex
+	hostGraphQLClients = make(map[string]*gh.GraphQLClient)
+	hostRESTClients    = make(map[string]*gh.RESTClient)
+)
+
+func defaultGraphQLClient() (*gh.GraphQLClient, error) {
+	if config.IsFeatureEnabled(config.FF_MOCK_DATA) {
+		return gh.NewGraphQLClient(mockClientOptions())
+	}
+	return gh.NewGraphQLClient(clientOptions(""))
+}
+
+func defaultRESTClient() (*gh.RESTClient, error) {
+	if config.IsFeatureEnabled(config.FF_MOCK_DATA) {
+		return gh.NewRESTClient(mockClientOptions())
+	}
+	return gh.NewRESTClient(clientOptions(""))
+}
+
+// clientOptions are the options of the clients of host, the host gh is
+// logged into being used when it's empty
+func clientOptions(host string) gh.ClientOptions {
+	opts := gh.ClientOptions{
+		Host:      host,
+		Transport: &rateLimitTransport{host: host, base: http.DefaultTransport},
+	}
+	if os.Getenv("LOG_LEVEL") == "debug" {
+		logger := NewHTTPLogger(0)
+		opts.Log = &logger
+		opts.LogVerboseHTTP = true
+		opts.LogColorize = true
+	}
+	return opts
+}
+
+// isDefaultHost is true when host is served by the default clients
+func isDefaultHost(host string) bool {
+	if host == "" || config.IsFeatureEnabled(config.FF_MOCK_DATA) {
+		return true
+	}
+	defaultHost, _ := auth.DefaultHost()
+	return auth.NormalizeHostname(host) == auth.NormalizeHostname(defaultHost)
+}
+
+// hostKey identifies the host the clients and rate limits are kept by, it's
+// empty for the default host
+func hostKey(host string) string {
+	if isDefaultHost(host) {
+		return ""
+	}
+	return auth.NormalizeHostname(host)
+}
+
+// HostOf returns the host of a github.com or GitHub Enterprise Server URL,
+// or an empty string when it's the default host
+func HostOf(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	return hostKey(u.Hostname())
+}
+
+// graphQLClientForHost returns the GraphQL client of host, the default
+// client when host is empty
+func graphQLClientForHost(host string) (*gh.GraphQLClient, error) {
+	host = hostKey(host)
+	if host == "" {
+		var err error
+		if client == nil {
+			client, err = defaultGraphQLClient()
+		}
+		return client, err
+	}
+
+	hostClientsMu.Lock()
+	defer hostClientsMu.Unlock()
+	if c, ok := hostGraphQLClients[host]; ok {
+		return c, nil
+	}
+	c, err := gh.NewGraphQLClient(clientOptions(host))
+	if err != nil {
+		return nil, err
+	}
+	hostGraphQLClients[host] = c
+	return c, nil
+}
+
+// restClientForHost returns the REST client of host, the default client when
+// host is empty
+func restClientForHost(host string) (*gh.RESTClient, error) {
+	host = hostKey(host)
+	if host == "" {
+		return getRESTClient()
+	}
+
+	hostClientsMu.Lock()
+	defer hostClientsMu.Unlock()
+	if c, ok := hostRESTClients[host]; ok {
+		return c, nil
+	}
+	c, err := gh.NewRESTClient(clientOptions(host))
+	if err != nil {
+		return nil, err
+	}
+	hostRESTClients[host] = c
+	return c, nil
+}
+
+// mockClientOptions points a client at the mock server, which serves a self
+// signed certificate
+func mockClientOptions() gh.ClientOptions {
+	log.Info("using mock data", "server", "https://"+MockDataHost)
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	return gh.ClientOptions{
+		Host:      MockDataHost,
+		AuthToken: "fake-token",
+		Transport: &rateLimitTransport{base: transport},
+	}
+}
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostOf(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "empty url", url: "", want: ""},
+		{name: "github.com", url: "https://github.com/dlvhdr/gh-dash/pull/1", want: ""},
+		{
+			name: "github.com api",
+			url:  "https://api.github.com/repos/dlvhdr/gh-dash/issues/comments/1",
+			want: "",
+		},
+		{
+			name: "enterprise server",
+			url:  "https://GHE.example.com/acme/widgets/pull/7",
+			want: "ghe.example.com",
+		},
+		{
+			name: "enterprise server api",
+			url:  "https://ghe.example.com/api/v3/repos/acme/widgets/issues/comments/1",
+			want: "ghe.example.com",
+		},
+		{name: "not a url", url: "acme/widgets", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, HostOf(tt.url))
+		})
+	}
+}
+
+func TestHostOf_DefaultHostIsEnterprise(t *testing.T) {
+	t.Setenv("GH_HOST", "ghe.example.com")
+
+	require.Equal(t, "", HostOf("https://ghe.example.com/acme/widgets/pull/7"))
+	require.Equal(t, "github.com", HostOf("https://github.com/dlvhdr/gh-dash/pull/1"))
+}
+
+func TestClientsForHost(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+	t.Setenv("GH_ENTERPRISE_TOKEN", "fake-token")
+
+	first, err := graphQLClientForHost("ghe.example.com")
+	require.NoError(t, err)
+	second, err := graphQLClientForHost("ghe.example.com")
+	require.NoError(t, err)
+	require.Same(t, first, second, "clients should be reused per host")
+
+	other, err := graphQLClientForHost("ghe.other.com")
+	require.NoError(t, err)
+	require.NotSame(t, first, other)
+
+	rest, err := restClientForHost("ghe.example.com")
+	require.NoError(t, err)
+	require.NotNil(t, rest)
+}
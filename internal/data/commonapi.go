@@ -1,8 +1,9 @@
 package data
 
 import (
+	"fmt"
+
 	"charm.land/log/v2"
-	gh "github.com/cli/go-gh/v2/pkg/api"
 	graphql "github.com/cli/shurcooL-graphql"
 )
 
@@ -18,7 +19,7 @@ func FetchLatestVersion() (VersionResponse, error) {
 	var queryResult VersionResponse
 	var err error
 	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
+		client, err = defaultGraphQLClient()
 	}
 	if err != nil {
 		return VersionResponse{}, err
@@ -62,7 +63,7 @@ func FetchSponsors() (SponsorsResponse, error) {
 	var queryResult SponsorsResponse
 	var err error
 	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
+		client, err = defaultGraphQLClient()
 	}
 	if err != nil {
 		return SponsorsResponse{}, err
@@ -81,3 +82,50 @@ func FetchSponsors() (SponsorsResponse, error) {
 
 	return queryResult, nil
 }
+
+// FetchIssueOrPullRequestUrl returns the URL of the issue or PR with the given
+// number on host, and whether it's a PR
+func FetchIssueOrPullRequestUrl(
+	owner, repo string,
+	number int,
+	host string,
+) (string, bool, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return "", false, err
+	}
+
+	var queryResult struct {
+		Repository struct {
+			IssueOrPullRequest struct {
+				Typename string `graphql:"__typename"`
+				Issue    struct {
+					Url string
+				} `graphql:"... on Issue"`
+				PullRequest struct {
+					Url string
+				} `graphql:"... on PullRequest"`
+			} `graphql:"issueOrPullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner":  graphql.String(owner),
+		"name":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+	log.Debug("Fetching issue or PR", "owner", owner, "repo", repo, "number", number)
+	err = client.Query("FetchIssueOrPullRequest", &queryResult, variables)
+	if err != nil {
+		return "", false, err
+	}
+
+	result := queryResult.Repository.IssueOrPullRequest
+	switch result.Typename {
+	case "PullRequest":
+		return result.PullRequest.Url, true, nil
+	case "Issue":
+		return result.Issue.Url, false, nil
+	default:
+		return "", false, fmt.Errorf("%s/%s#%d is not an issue or a PR", owner, repo, number)
+	}
+}
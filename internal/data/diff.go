@@ -0,0 +1,100 @@
+package data
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DiffSide is the side of a diff a review comment is attached to.
+// LEFT is the base version of the file, RIGHT is the head version.
+type DiffSide string
+
+const (
+	DiffSideLeft  DiffSide = "LEFT"
+	DiffSideRight DiffSide = "RIGHT"
+)
+
+type DiffLineType int
+
+const (
+	DiffLineHunk DiffLineType = iota
+	DiffLineContext
+	DiffLineAddition
+	DiffLineDeletion
+)
+
+// DiffLine is a single line of a parsed patch.
+// OldLine and NewLine are 0 when the line doesn't exist on that side.
+type DiffLine struct {
+	Type    DiffLineType
+	Content string
+	OldLine int
+	NewLine int
+}
+
+// IsCommentable returns whether a review comment can be attached to the line.
+func (l DiffLine) IsCommentable() bool {
+	return l.Type != DiffLineHunk
+}
+
+// Side returns the diff side a comment on this line should be attached to.
+func (l DiffLine) Side() DiffSide {
+	if l.Type == DiffLineDeletion {
+		return DiffSideLeft
+	}
+	return DiffSideRight
+}
+
+// Line returns the line number of the line on its Side.
+func (l DiffLine) Line() int {
+	if l.Type == DiffLineDeletion {
+		return l.OldLine
+	}
+	return l.NewLine
+}
+
+// hunkHeaderRegex matches "@@ -1,2 +3,4 @@" hunk headers
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// ParsePatch parses the unified diff patch of a single file, as returned by
+// the GitHub API, into lines annotated with their old and new line numbers.
+func ParsePatch(patch string) []DiffLine {
+	lines := make([]DiffLine, 0)
+	oldLine, newLine := 0, 0
+
+	for raw := range strings.SplitSeq(patch, "\n") {
+		raw = strings.TrimSuffix(raw, "\r")
+		if match := hunkHeaderRegex.FindStringSubmatch(raw); match != nil {
+			oldLine, _ = strconv.Atoi(match[1])
+			newLine, _ = strconv.Atoi(match[2])
+			lines = append(lines, DiffLine{Type: DiffLineHunk, Content: raw})
+			continue
+		}
+
+		if raw == "" || strings.HasPrefix(raw, `\`) {
+			// Skip "\ No newline at end of file" markers and the trailing newline
+			continue
+		}
+
+		switch raw[0] {
+		case '+':
+			lines = append(lines, DiffLine{Type: DiffLineAddition, Content: raw[1:], NewLine: newLine})
+			newLine++
+		case '-':
+			lines = append(lines, DiffLine{Type: DiffLineDeletion, Content: raw[1:], OldLine: oldLine})
+			oldLine++
+		default:
+			lines = append(lines, DiffLine{
+				Type:    DiffLineContext,
+				Content: raw[1:],
+				OldLine: oldLine,
+				NewLine: newLine,
+			})
+			oldLine++
+			newLine++
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePatch(t *testing.T) {
+	patch := "@@ -1,3 +1,4 @@\n" +
+		" package main\n" +
+		"-import \"fmt\"\n" +
+		"+import (\n" +
+		"+\t\"fmt\"\n" +
+		"+)\n" +
+		"@@ -10,2 +11,2 @@ func main() {\n" +
+		" \tfmt.Println(\"hi\")\n" +
+		"-}\n" +
+		"\\ No newline at end of file\n" +
+		"+}\n"
+
+	lines := ParsePatch(patch)
+
+	require.Equal(t, []DiffLine{
+		{Type: DiffLineHunk, Content: "@@ -1,3 +1,4 @@"},
+		{Type: DiffLineContext, Content: "package main", OldLine: 1, NewLine: 1},
+		{Type: DiffLineDeletion, Content: "import \"fmt\"", OldLine: 2},
+		{Type: DiffLineAddition, Content: "import (", NewLine: 2},
+		{Type: DiffLineAddition, Content: "\t\"fmt\"", NewLine: 3},
+		{Type: DiffLineAddition, Content: ")", NewLine: 4},
+		{Type: DiffLineHunk, Content: "@@ -10,2 +11,2 @@ func main() {"},
+		{Type: DiffLineContext, Content: "\tfmt.Println(\"hi\")", OldLine: 10, NewLine: 11},
+		{Type: DiffLineDeletion, Content: "}", OldLine: 11},
+		{Type: DiffLineAddition, Content: "}", NewLine: 12},
+	}, lines)
+}
+
+func TestParsePatchEmpty(t *testing.T) {
+	require.Empty(t, ParsePatch(""))
+}
+
+func TestDiffLineSideAndLine(t *testing.T) {
+	deletion := DiffLine{Type: DiffLineDeletion, OldLine: 7}
+	require.Equal(t, DiffSideLeft, deletion.Side())
+	require.Equal(t, 7, deletion.Line())
+
+	addition := DiffLine{Type: DiffLineAddition, NewLine: 9}
+	require.Equal(t, DiffSideRight, addition.Side())
+	require.Equal(t, 9, addition.Line())
+
+	context := DiffLine{Type: DiffLineContext, OldLine: 3, NewLine: 4}
+	require.Equal(t, DiffSideRight, context.Side())
+	require.Equal(t, 4, context.Line())
+
+	require.False(t, DiffLine{Type: DiffLineHunk}.IsCommentable())
+	require.True(t, context.IsCommentable())
+}
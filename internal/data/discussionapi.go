@@ -0,0 +1,277 @@
+package data
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"charm.land/log/v2"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+type DiscussionData struct {
+	Id     string
+	Number int
+	Title  string
+	Body   string
+	Author struct {
+		Login string
+	}
+	AuthorAssociation string
+	UpdatedAt         time.Time
+	CreatedAt         time.Time
+	Url               string
+	Closed            bool
+	Locked            bool
+	IsAnswered        bool
+	UpvoteCount       int
+	Category          DiscussionCategory
+	Repository        Repository
+	Comments          DiscussionComments `graphql:"comments(first: 30)"`
+	Labels            IssueLabels        `graphql:"labels(first: 20)"`
+}
+
+type DiscussionCategory struct {
+	Name         string
+	IsAnswerable bool
+}
+
+type DiscussionComments struct {
+	Nodes      []DiscussionComment
+	TotalCount int
+}
+
+type DiscussionComment struct {
+	Id     string
+	Author struct {
+		Login string
+	}
+	Body        string
+	CreatedAt   time.Time
+	IsAnswer    bool
+	UpvoteCount int
+	Replies     DiscussionReplies `graphql:"replies(first: 20)"`
+}
+
+type DiscussionReplies struct {
+	Nodes      []DiscussionReply
+	TotalCount int
+}
+
+type DiscussionReply struct {
+	Id     string
+	Author struct {
+		Login string
+	}
+	Body      string
+	CreatedAt time.Time
+}
+
+func (data DiscussionData) GetAuthor(theme theme.Theme, showAuthorIcons bool) string {
+	author := data.Author.Login
+	if showAuthorIcons {
+		author += fmt.Sprintf(" %s", GetAuthorRoleIcon(data.AuthorAssociation, theme))
+	}
+	return author
+}
+
+func (data DiscussionData) GetTitle() string {
+	return data.Title
+}
+
+func (data DiscussionData) GetRepoNameWithOwner() string {
+	return data.Repository.NameWithOwner
+}
+
+func (data DiscussionData) GetRepoNameAndOwner() (owner, repoName string) {
+	return data.Repository.Owner.Login, data.Repository.Name
+}
+
+func (data DiscussionData) GetNumber() int {
+	return data.Number
+}
+
+// GetState returns the state of the discussion in the same terms as issues
+func (data DiscussionData) GetState() string {
+	if data.Closed {
+		return "CLOSED"
+	}
+	return "OPEN"
+}
+
+func (data DiscussionData) GetUrl() string {
+	return data.Url
+}
+
+func (data DiscussionData) GetUpdatedAt() time.Time {
+	return data.UpdatedAt
+}
+
+func (data DiscussionData) GetCreatedAt() time.Time {
+	return data.CreatedAt
+}
+
+func makeDiscussionsQuery(query string) string {
+	return fmt.Sprintf("archived:false %s sort:updated", query)
+}
+
+func FetchDiscussions(query string, limit int, pageInfo *PageInfo, host string) (DiscussionsResponse, error) {
+	if err := checkRateLimit(host, RateLimitGraphQL); err != nil {
+		return DiscussionsResponse{}, err
+	}
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return DiscussionsResponse{}, err
+	}
+
+	var queryResult struct {
+		Search    discussionsSearch `graphql:"search(type: DISCUSSION, first: $limit, after: $endCursor, query: $query)"`
+		RateLimit graphQLRateLimit  `graphql:"rateLimit"`
+	}
+	var endCursor *string
+	if pageInfo != nil {
+		endCursor = &pageInfo.EndCursor
+	}
+	variables := map[string]any{
+		"query":     graphql.String(makeDiscussionsQuery(query)),
+		"limit":     graphql.Int(limit),
+		"endCursor": (*graphql.String)(endCursor),
+	}
+	log.Debug("Fetching discussions", "query", query, "limit", limit, "endCursor", endCursor)
+	err = client.Query("SearchDiscussions", &queryResult, variables)
+	if err != nil {
+		return DiscussionsResponse{}, explainRateLimitError(host, RateLimitGraphQL, err)
+	}
+	recordGraphQLRateLimit(host, queryResult.RateLimit)
+	log.Info("Successfully fetched discussions", "query", query, "count", queryResult.Search.DiscussionCount)
+
+	return queryResult.Search.response(), nil
+}
+
+type DiscussionsResponse struct {
+	Discussions []DiscussionData
+	TotalCount  int
+	PageInfo    PageInfo
+}
+
+// discussionsSearch is the result of a search for discussions
+type discussionsSearch struct {
+	Nodes []struct {
+		Discussion DiscussionData `graphql:"... on Discussion"`
+	}
+	DiscussionCount int
+	PageInfo        PageInfo
+}
+
+func (s discussionsSearch) response() DiscussionsResponse {
+	discussions := make([]DiscussionData, 0, len(s.Nodes))
+	for _, node := range s.Nodes {
+		discussions = append(discussions, node.Discussion)
+	}
+
+	return DiscussionsResponse{
+		Discussions: discussions,
+		TotalCount:  s.DiscussionCount,
+		PageInfo:    s.PageInfo,
+	}
+}
+
+// FetchDiscussion fetches a single discussion by its GitHub URL
+func FetchDiscussion(discussionUrl string) (DiscussionData, error) {
+	client, err := graphQLClientForHost(HostOf(discussionUrl))
+	if err != nil {
+		return DiscussionData{}, err
+	}
+
+	var queryResult struct {
+		Resource struct {
+			Discussion DiscussionData `graphql:"... on Discussion"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(discussionUrl)
+	if err != nil {
+		return DiscussionData{}, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching Discussion", "url", discussionUrl)
+	err = client.Query("FetchDiscussion", &queryResult, variables)
+	if err != nil {
+		return DiscussionData{}, err
+	}
+	log.Info("Successfully fetched Discussion", "url", discussionUrl)
+
+	return queryResult.Resource.Discussion, nil
+}
+
+// AddDiscussionComment comments on a discussion, or replies to one of its
+// comments when replyToId isn't empty. Replies are returned as comments
+// without replies of their own.
+func AddDiscussionComment(
+	discussionId, replyToId, body string,
+	host string,
+) (DiscussionComment, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return DiscussionComment{}, err
+	}
+
+	var mutation struct {
+		AddDiscussionComment struct {
+			Comment DiscussionComment
+		} `graphql:"addDiscussionComment(input: $input)"`
+	}
+	input := githubv4.AddDiscussionCommentInput{
+		DiscussionID: discussionId,
+		Body:         githubv4.String(body),
+	}
+	if replyToId != "" {
+		input.ReplyToID = githubv4.NewID(replyToId)
+	}
+	variables := map[string]any{
+		"input": input,
+	}
+	log.Debug("Commenting on discussion", "discussion", discussionId, "replyTo", replyToId)
+	err = client.Mutate("AddDiscussionComment", &mutation, variables)
+	if err != nil {
+		return DiscussionComment{}, err
+	}
+
+	return mutation.AddDiscussionComment.Comment, nil
+}
+
+// SetDiscussionAnswer marks a comment as the answer of its discussion, or
+// unmarks it
+func SetDiscussionAnswer(commentId string, isAnswer bool, host string) error {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Setting discussion answer", "comment", commentId, "isAnswer", isAnswer)
+	if isAnswer {
+		var mutation struct {
+			MarkDiscussionCommentAsAnswer struct {
+				ClientMutationId string
+			} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+		}
+		variables := map[string]any{
+			"input": githubv4.MarkDiscussionCommentAsAnswerInput{ID: commentId},
+		}
+		return client.Mutate("MarkDiscussionCommentAsAnswer", &mutation, variables)
+	}
+
+	var mutation struct {
+		UnmarkDiscussionCommentAsAnswer struct {
+			ClientMutationId string
+		} `graphql:"unmarkDiscussionCommentAsAnswer(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.UnmarkDiscussionCommentAsAnswerInput{ID: commentId},
+	}
+	return client.Mutate("UnmarkDiscussionCommentAsAnswer", &mutation, variables)
+}
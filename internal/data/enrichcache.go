@@ -0,0 +1,179 @@
+package data
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+)
+
+// enrichmentCacheSize is how many PRs and issues the enrichment cache keeps
+// each. It's enough for the rows of a few sections and their neighbours.
+const enrichmentCacheSize = 64
+
+// lruCache keeps the last used values of type T by URL, along with when the
+// item they were fetched for was last updated
+type lruCache[T any] struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry[T any] struct {
+	url       string
+	updatedAt time.Time
+	value     T
+}
+
+func newLRUCache[T any](size int) *lruCache[T] {
+	return &lruCache[T]{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// get returns the value of url unless it was fetched before the item was
+// updated at updatedAt
+func (c *lruCache[T]) get(url string, updatedAt time.Time) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+	elem, ok := c.entries[url]
+	if !ok {
+		return zero, false
+	}
+	entry := elem.Value.(*lruEntry[T])
+	if entry.updatedAt.Before(updatedAt) {
+		return zero, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *lruCache[T]) put(url string, updatedAt time.Time, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[url]; ok {
+		entry := elem.Value.(*lruEntry[T])
+		entry.updatedAt = updatedAt
+		entry.value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[url] = c.order.PushFront(&lruEntry[T]{url: url, updatedAt: updatedAt, value: value})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[T]).url)
+	}
+}
+
+func (c *lruCache[T]) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	clear(c.entries)
+}
+
+func (c *lruCache[T]) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// fetchGroup shares the result of a fetch between the callers asking for the
+// same URL while it's in flight, so a prefetch and the enrichment of the
+// selected row don't both hit the API
+type fetchGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*fetchCall[T]
+}
+
+type fetchCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func (g *fetchGroup[T]) do(url string, fetch func() (T, error)) (T, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*fetchCall[T])
+	}
+	if call, ok := g.calls[url]; ok {
+		g.mu.Unlock()
+		<-call.done
+		return call.value, call.err
+	}
+	call := &fetchCall[T]{done: make(chan struct{})}
+	g.calls[url] = call
+	g.mu.Unlock()
+
+	call.value, call.err = fetch()
+	close(call.done)
+
+	g.mu.Lock()
+	delete(g.calls, url)
+	g.mu.Unlock()
+	return call.value, call.err
+}
+
+func (g *fetchGroup[T]) inFlight(url string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	_, ok := g.calls[url]
+	return ok
+}
+
+var (
+	enrichedPrs    = newLRUCache[EnrichedPullRequestData](enrichmentCacheSize)
+	enrichedIssues = newLRUCache[IssueData](enrichmentCacheSize)
+	prFetches      fetchGroup[EnrichedPullRequestData]
+	issueFetches   fetchGroup[IssueData]
+)
+
+// CachedPullRequest returns the enriched data of the PR at prUrl when it was
+// fetched since the PR was updated at updatedAt
+func CachedPullRequest(prUrl string, updatedAt time.Time) (EnrichedPullRequestData, bool) {
+	return enrichedPrs.get(prUrl, updatedAt)
+}
+
+// CachedIssue returns the issue at issueUrl when it was fetched since the
+// issue was updated at updatedAt
+func CachedIssue(issueUrl string, updatedAt time.Time) (IssueData, bool) {
+	return enrichedIssues.get(issueUrl, updatedAt)
+}
+
+// PrefetchPullRequest fetches the PR at prUrl into the enrichment cache,
+// unless it's already there or being fetched. Prefetching is skipped while
+// the rate limit budget is low, as the rows may never be selected.
+func PrefetchPullRequest(prUrl string, updatedAt time.Time) {
+	if _, ok := CachedPullRequest(prUrl, updatedAt); ok || prFetches.inFlight(prUrl) {
+		return
+	}
+	if rl, ok := LowestRateLimit(time.Now()); ok && rl.IsLow() {
+		return
+	}
+	if _, err := FetchPullRequest(prUrl); err != nil {
+		log.Debug("Failed prefetching PR", "url", prUrl, "err", err)
+	}
+}
+
+// PrefetchIssue fetches the issue at issueUrl into the enrichment cache, the
+// same way PrefetchPullRequest does for PRs
+func PrefetchIssue(issueUrl string, updatedAt time.Time) {
+	if _, ok := CachedIssue(issueUrl, updatedAt); ok || issueFetches.inFlight(issueUrl) {
+		return
+	}
+	if rl, ok := LowestRateLimit(time.Now()); ok && rl.IsLow() {
+		return
+	}
+	if _, err := FetchIssue(issueUrl); err != nil {
+		log.Debug("Failed prefetching issue", "url", issueUrl, "err", err)
+	}
+}
@@ -0,0 +1,107 @@
+package data
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache_Evicts(t *testing.T) {
+	c := newLRUCache[int](2)
+	now := time.Now()
+	c.put("a", now, 1)
+	c.put("b", now, 2)
+
+	// Using a makes b the least recently used
+	_, ok := c.get("a", now)
+	require.True(t, ok)
+	c.put("c", now, 3)
+
+	require.Equal(t, 2, c.len())
+	_, ok = c.get("b", now)
+	require.False(t, ok)
+	v, ok := c.get("a", now)
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+}
+
+func TestLRUCache_IgnoresStaleEntries(t *testing.T) {
+	c := newLRUCache[int](2)
+	fetchedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.put("a", fetchedAt, 1)
+
+	_, ok := c.get("a", fetchedAt.Add(-time.Hour))
+	require.True(t, ok)
+	_, ok = c.get("a", fetchedAt)
+	require.True(t, ok)
+	_, ok = c.get("a", fetchedAt.Add(time.Minute))
+	require.False(t, ok, "the item was updated since it was fetched")
+
+	c.put("a", fetchedAt.Add(time.Minute), 2)
+	v, ok := c.get("a", fetchedAt.Add(time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 2, v)
+	require.Equal(t, 1, c.len())
+}
+
+func TestClearEnrichmentCache_PurgesData(t *testing.T) {
+	now := time.Now()
+	enrichedPrs.put("https://github.com/o/r/pull/1", now, EnrichedPullRequestData{Number: 1})
+	enrichedIssues.put("https://github.com/o/r/issues/2", now, IssueData{Number: 2})
+
+	ClearEnrichmentCache()
+
+	_, ok := CachedPullRequest("https://github.com/o/r/pull/1", now)
+	require.False(t, ok)
+	_, ok = CachedIssue("https://github.com/o/r/issues/2", now)
+	require.False(t, ok)
+}
+
+func TestFetchGroup_SharesInFlightFetches(t *testing.T) {
+	var g fetchGroup[int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]int, 3)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = g.do("a", func() (int, error) {
+			calls.Add(1)
+			close(started)
+			<-release
+			return 7, nil
+		})
+	}()
+	<-started
+	require.True(t, g.inFlight("a"))
+	waiting := make(chan struct{})
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			waiting <- struct{}{}
+			results[i], _ = g.do("a", func() (int, error) {
+				calls.Add(1)
+				return 0, nil
+			})
+		}()
+	}
+	for i := 1; i < len(results); i++ {
+		<-waiting
+	}
+	// Give the other callers the time to wait on the first fetch, as they
+	// may not be scheduled right away on a busy machine
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, []int{7, 7, 7}, results)
+	require.EqualValues(t, 1, calls.Load())
+	require.False(t, g.inFlight("a"))
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"charm.land/log/v2"
-	gh "github.com/cli/go-gh/v2/pkg/api"
 	graphql "github.com/cli/shurcooL-graphql"
 	"github.com/shurcooL/githubv4"
 
@@ -99,24 +98,18 @@ func makeIssuesQuery(query string) string {
 	return fmt.Sprintf("is:issue archived:false %s sort:updated", query)
 }
 
-func FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, error) {
-	var err error
-	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
+func FetchIssues(query string, limit int, pageInfo *PageInfo, host string) (IssuesResponse, error) {
+	if err := checkRateLimit(host, RateLimitGraphQL); err != nil {
+		return IssuesResponse{}, err
 	}
-
+	client, err := graphQLClientForHost(host)
 	if err != nil {
 		return IssuesResponse{}, err
 	}
 
 	var queryResult struct {
-		Search struct {
-			Nodes []struct {
-				Issue IssueData `graphql:"... on Issue"`
-			}
-			IssueCount int
-			PageInfo   PageInfo
-		} `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
+		Search    issuesSearch     `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
+		RateLimit graphQLRateLimit `graphql:"rateLimit"`
 	}
 	var endCursor *string
 	if pageInfo != nil {
@@ -130,20 +123,12 @@ func FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, e
 	log.Debug("Fetching issues", "query", query, "limit", limit, "endCursor", endCursor)
 	err = client.Query("SearchIssues", &queryResult, variables)
 	if err != nil {
-		return IssuesResponse{}, err
+		return IssuesResponse{}, explainRateLimitError(host, RateLimitGraphQL, err)
 	}
+	recordGraphQLRateLimit(host, queryResult.RateLimit)
 	log.Info("Successfully fetched issues", "query", query, "count", queryResult.Search.IssueCount)
 
-	issues := make([]IssueData, 0, len(queryResult.Search.Nodes))
-	for _, node := range queryResult.Search.Nodes {
-		issues = append(issues, node.Issue)
-	}
-
-	return IssuesResponse{
-		Issues:     issues,
-		TotalCount: queryResult.Search.IssueCount,
-		PageInfo:   queryResult.Search.PageInfo,
-	}, nil
+	return queryResult.Search.response(), nil
 }
 
 type IssuesResponse struct {
@@ -152,14 +137,44 @@ type IssuesResponse struct {
 	PageInfo   PageInfo
 }
 
-// FetchIssue fetches a single issue by its GitHub URL
+// issuesSearch is the result of a search for issues
+type issuesSearch struct {
+	Nodes []struct {
+		Issue IssueData `graphql:"... on Issue"`
+	}
+	IssueCount int
+	PageInfo   PageInfo
+}
+
+func (s issuesSearch) response() IssuesResponse {
+	issues := make([]IssueData, 0, len(s.Nodes))
+	for _, node := range s.Nodes {
+		issues = append(issues, node.Issue)
+	}
+
+	return IssuesResponse{
+		Issues:     issues,
+		TotalCount: s.IssueCount,
+		PageInfo:   s.PageInfo,
+	}
+}
+
+// FetchIssue fetches a single issue by its GitHub URL and keeps it in the
+// enrichment cache
 func FetchIssue(issueUrl string) (IssueData, error) {
-	var err error
-	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
-		if err != nil {
-			return IssueData{}, err
+	return issueFetches.do(issueUrl, func() (IssueData, error) {
+		issue, err := fetchIssue(issueUrl)
+		if err == nil {
+			enrichedIssues.put(issueUrl, issue.UpdatedAt, issue)
 		}
+		return issue, err
+	})
+}
+
+func fetchIssue(issueUrl string) (IssueData, error) {
+	client, err := graphQLClientForHost(HostOf(issueUrl))
+	if err != nil {
+		return IssueData{}, err
 	}
 
 	var queryResult struct {
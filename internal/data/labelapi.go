@@ -16,16 +16,16 @@ var (
 	execCommand = exec.Command
 )
 
-func CachedRepoLabels(repoNameWithOwner string) ([]Label, bool) {
+func CachedRepoLabels(repoNameWithOwner string, host string) ([]Label, bool) {
 	labelCacheMu.RLock()
 	defer labelCacheMu.RUnlock()
-	labels, ok := repoLabelCache[repoNameWithOwner]
+	labels, ok := repoLabelCache[repoCacheKey(repoNameWithOwner, host)]
 	return labels, ok
 }
 
-func FetchRepoLabels(repoNameWithOwner string) ([]Label, error) {
+func FetchRepoLabels(repoNameWithOwner string, host string) ([]Label, error) {
 	// Check cache first
-	if cachedLabels, ok := CachedRepoLabels(repoNameWithOwner); ok {
+	if cachedLabels, ok := CachedRepoLabels(repoNameWithOwner, host); ok {
 		return cachedLabels, nil
 	}
 
@@ -36,7 +36,7 @@ func FetchRepoLabels(repoNameWithOwner string) ([]Label, error) {
 		"label",
 		"list",
 		"-R",
-		repoNameWithOwner,
+		repoCacheKey(repoNameWithOwner, host),
 		"--json",
 		"name,description,color",
 		"--limit",
@@ -62,11 +62,12 @@ func FetchRepoLabels(repoNameWithOwner string) ([]Label, error) {
 	labelCacheMu.Lock()
 	defer labelCacheMu.Unlock()
 
-	if labels, ok := repoLabelCache[repoNameWithOwner]; ok {
+	key := repoCacheKey(repoNameWithOwner, host)
+	if labels, ok := repoLabelCache[key]; ok {
 		return labels, nil
 	}
 
-	repoLabelCache[repoNameWithOwner] = filteredLabels
+	repoLabelCache[key] = filteredLabels
 	log.Debug(
 		"Successfully fetched repo labels",
 		"repoNameWithOwner",
@@ -83,10 +84,10 @@ func ClearLabelCache() {
 	repoLabelCache = make(map[string][]Label)
 }
 
-func ClearRepoLabelCache(repoNameWithOwner string) {
+func ClearRepoLabelCache(repoNameWithOwner string, host string) {
 	labelCacheMu.Lock()
 	defer labelCacheMu.Unlock()
-	delete(repoLabelCache, repoNameWithOwner)
+	delete(repoLabelCache, repoCacheKey(repoNameWithOwner, host))
 }
 
 func LabelNames(labels []Label) []string {
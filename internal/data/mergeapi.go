@@ -0,0 +1,171 @@
+package data
+
+import (
+	"net/url"
+	"strings"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// MergeOptions describes how a PR is merged. The commit headline and body are
+// optional, GitHub picks a default message when they're empty.
+type MergeOptions struct {
+	Method         config.MergeMethod
+	CommitHeadline string
+	CommitBody     string
+	DeleteBranch   bool
+}
+
+type mergeTarget struct {
+	Id                string
+	IsCrossRepository bool
+	HeadRef           *struct {
+		Id string
+	}
+}
+
+func fetchMergeTarget(client *gh.GraphQLClient, prUrl string) (mergeTarget, error) {
+	parsedUrl, err := url.Parse(prUrl)
+	if err != nil {
+		return mergeTarget{}, err
+	}
+
+	var queryResult struct {
+		Resource struct {
+			PullRequest mergeTarget `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	err = client.Query("FetchMergeTarget", &queryResult, variables)
+	if err != nil {
+		return mergeTarget{}, err
+	}
+	return queryResult.Resource.PullRequest, nil
+}
+
+func mergeMethodPtr(method config.MergeMethod) *githubv4.PullRequestMergeMethod {
+	if method == "" {
+		return nil
+	}
+	m := githubv4.PullRequestMergeMethod(strings.ToUpper(string(method)))
+	return &m
+}
+
+func optionalString(s string) *githubv4.String {
+	if s == "" {
+		return nil
+	}
+	return githubv4.NewString(githubv4.String(s))
+}
+
+// MergePullRequest merges the PR right away, deleting its branch afterwards if
+// asked to. Branches of PRs from forks are never deleted.
+func MergePullRequest(prUrl string, opts MergeOptions) error {
+	client, err := graphQLClientForHost(HostOf(prUrl))
+	if err != nil {
+		return err
+	}
+	target, err := fetchMergeTarget(client, prUrl)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		MergePullRequest struct {
+			PullRequest struct {
+				Id string
+			}
+		} `graphql:"mergePullRequest(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.MergePullRequestInput{
+			PullRequestID:  target.Id,
+			MergeMethod:    mergeMethodPtr(opts.Method),
+			CommitHeadline: optionalString(opts.CommitHeadline),
+			CommitBody:     optionalString(opts.CommitBody),
+		},
+	}
+	log.Debug("Merging PR", "url", prUrl, "method", opts.Method)
+	if err := client.Mutate("MergePullRequest", &mutation, variables); err != nil {
+		return err
+	}
+
+	if !opts.DeleteBranch || target.IsCrossRepository || target.HeadRef == nil {
+		return nil
+	}
+
+	var deleteMutation struct {
+		DeleteRef struct {
+			ClientMutationId string
+		} `graphql:"deleteRef(input: $input)"`
+	}
+	deleteVariables := map[string]any{
+		"input": githubv4.DeleteRefInput{RefID: target.HeadRef.Id},
+	}
+	log.Debug("Deleting PR branch", "url", prUrl)
+	return client.Mutate("DeleteRef", &deleteMutation, deleteVariables)
+}
+
+// EnablePullRequestAutoMerge makes GitHub merge the PR once all of its
+// requirements are met. The API can't delete the branch then, so
+// opts.DeleteBranch is ignored and the repo's auto-delete setting applies.
+func EnablePullRequestAutoMerge(prUrl string, opts MergeOptions) error {
+	client, err := graphQLClientForHost(HostOf(prUrl))
+	if err != nil {
+		return err
+	}
+	target, err := fetchMergeTarget(client, prUrl)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				Id string
+			}
+		} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.EnablePullRequestAutoMergeInput{
+			PullRequestID:  target.Id,
+			MergeMethod:    mergeMethodPtr(opts.Method),
+			CommitHeadline: optionalString(opts.CommitHeadline),
+			CommitBody:     optionalString(opts.CommitBody),
+		},
+	}
+	log.Debug("Enabling auto-merge", "url", prUrl, "method", opts.Method)
+	return client.Mutate("EnablePullRequestAutoMerge", &mutation, variables)
+}
+
+// EnqueuePullRequest adds the PR to the merge queue of its base branch. Its
+// branch is deleted only if the repo deletes head branches automatically.
+func EnqueuePullRequest(prUrl string) error {
+	client, err := graphQLClientForHost(HostOf(prUrl))
+	if err != nil {
+		return err
+	}
+	target, err := fetchMergeTarget(client, prUrl)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry struct {
+				Id string
+			}
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.EnqueuePullRequestInput{PullRequestID: target.Id},
+	}
+	log.Debug("Adding PR to the merge queue", "url", prUrl)
+	return client.Mutate("EnqueuePullRequest", &mutation, variables)
+}
@@ -105,7 +105,7 @@ func getRESTClient() (*gh.RESTClient, error) {
 		return restClient, nil
 	}
 	var err error
-	restClient, err = gh.DefaultRESTClient()
+	restClient, err = defaultRESTClient()
 	return restClient, err
 }
 
@@ -123,8 +123,12 @@ func FetchNotifications(
 	repoFilters []string,
 	readState NotificationReadState,
 	pageInfo *PageInfo,
+	host string,
 ) (NotificationsResponse, error) {
-	client, err := getRESTClient()
+	if err := checkRateLimit(host, RateLimitCore); err != nil {
+		return NotificationsResponse{}, err
+	}
+	client, err := restClientForHost(host)
 	if err != nil {
 		return NotificationsResponse{}, err
 	}
@@ -151,7 +155,7 @@ func FetchNotifications(
 		log.Debug("Fetching notifications", "limit", limit, "page", page, "readState", readState)
 		err = client.Get(path, &allNotifications)
 		if err != nil {
-			return NotificationsResponse{}, err
+			return NotificationsResponse{}, explainRateLimitError(host, RateLimitCore, err)
 		}
 	} else {
 		// Fetch notifications for each repo and combine
@@ -243,8 +247,8 @@ func FetchNotifications(
 // FetchNotificationByThreadId fetches a single notification by its thread ID.
 // This is useful for fetching bookmarked or session-marked-read notifications
 // that may not appear in the regular notifications list.
-func FetchNotificationByThreadId(threadId string) (*NotificationData, error) {
-	client, err := getRESTClient()
+func FetchNotificationByThreadId(threadId string, host string) (*NotificationData, error) {
+	client, err := restClientForHost(host)
 	if err != nil {
 		return nil, err
 	}
@@ -261,8 +265,8 @@ func FetchNotificationByThreadId(threadId string) (*NotificationData, error) {
 	return &notification, nil
 }
 
-func MarkNotificationDone(threadId string) error {
-	client, err := getRESTClient()
+func MarkNotificationDone(threadId string, host string) error {
+	client, err := restClientForHost(host)
 	if err != nil {
 		return err
 	}
@@ -279,8 +283,8 @@ func MarkNotificationDone(threadId string) error {
 	return nil
 }
 
-func MarkNotificationRead(threadId string) error {
-	client, err := getRESTClient()
+func MarkNotificationRead(threadId string, host string) error {
+	client, err := restClientForHost(host)
 	if err != nil {
 		return err
 	}
@@ -298,8 +302,8 @@ func MarkNotificationRead(threadId string) error {
 	return nil
 }
 
-func UnsubscribeFromThread(threadId string) error {
-	client, err := getRESTClient()
+func UnsubscribeFromThread(threadId string, host string) error {
+	client, err := restClientForHost(host)
 	if err != nil {
 		return err
 	}
@@ -317,8 +321,8 @@ func UnsubscribeFromThread(threadId string) error {
 	return nil
 }
 
-func MarkAllNotificationsRead() error {
-	client, err := getRESTClient()
+func MarkAllNotificationsRead(host string) error {
+	client, err := restClientForHost(host)
 	if err != nil {
 		return err
 	}
@@ -360,12 +364,13 @@ type WorkflowRunsResponse struct {
 
 // FetchCommentAuthor fetches the author of a comment from its API URL
 // apiUrl is like: https://api.github.com/repos/owner/repo/issues/comments/123456
+// GitHub Enterprise Server URLs are requested as they are.
 func FetchCommentAuthor(apiUrl string) (string, error) {
 	if apiUrl == "" {
 		return "", nil
 	}
 
-	client, err := getRESTClient()
+	client, err := restClientForHost(HostOf(apiUrl))
 	if err != nil {
 		return "", err
 	}
@@ -428,8 +433,9 @@ func FetchRecentWorkflowRun(
 	repo string,
 	notificationUpdatedAt time.Time,
 	title string,
+	host string,
 ) (string, error) {
-	client, err := getRESTClient()
+	client, err := restClientForHost(host)
 	if err != nil {
 		return "", err
 	}
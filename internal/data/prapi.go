@@ -1,11 +1,8 @@
 package data
 
 import (
-	"crypto/tls"
 	"fmt"
-	"net/http"
 	"net/url"
-	"os"
 	"time"
 
 	"charm.land/log/v2"
@@ -14,7 +11,6 @@ import (
 	checks "github.com/dlvhdr/x/gh-checks"
 	"github.com/shurcooL/githubv4"
 
-	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 )
 
@@ -27,6 +23,7 @@ type SuggestedReviewer struct {
 }
 
 type EnrichedPullRequestData struct {
+	Id      string
 	Url     string
 	Number  int
 	Title   string
@@ -111,6 +108,9 @@ type LastCommitStatus struct {
 }
 
 type CheckRun struct {
+	Id string
+	// DatabaseId is the id of the job when the check is a GitHub Actions job
+	DatabaseId int64
 	Name       graphql.String
 	Status     graphql.String
 	Conclusion checks.CheckRunState
@@ -119,13 +119,36 @@ type CheckRun struct {
 			Login graphql.String
 		}
 		WorkflowRun struct {
-			Workflow struct {
+			DatabaseId int64
+			Workflow   struct {
 				Name graphql.String
 			}
 		}
 	}
 }
 
+// CheckAnnotation is a message a check run reported on a line range of a
+// file, e.g. a linter error
+type CheckAnnotation struct {
+	Path            graphql.String
+	AnnotationLevel graphql.String
+	Title           graphql.String
+	Message         graphql.String
+	Location        struct {
+		Start struct {
+			Line graphql.Int
+		}
+		End struct {
+			Line graphql.Int
+		}
+	}
+}
+
+type CheckAnnotations struct {
+	TotalCount graphql.Int
+	Nodes      []CheckAnnotation
+}
+
 type StatusContext struct {
 	Context graphql.String
 	State   graphql.String
@@ -254,6 +277,7 @@ type Comment struct {
 }
 
 type ReviewComment struct {
+	Id     string
 	Author struct {
 		Login string
 	}
@@ -294,16 +318,22 @@ type Reviews struct {
 	Nodes      []Review
 }
 
+type ReviewThread struct {
+	Id                 string
+	IsOutdated         bool
+	IsResolved         bool
+	ViewerCanResolve   bool
+	ViewerCanUnresolve bool
+	ViewerCanReply     bool
+	OriginalLine       int
+	StartLine          int
+	Line               int
+	Path               string
+	Comments           ReviewComments `graphql:"comments(first: 20)"`
+}
+
 type ReviewThreadsWithComments struct {
-	Nodes []struct {
-		Id           string
-		IsOutdated   bool
-		OriginalLine int
-		StartLine    int
-		Line         int
-		Path         string
-		Comments     ReviewComments `graphql:"comments(first: 20)"`
-	}
+	Nodes []ReviewThread
 }
 
 type ChangedFile struct {
@@ -483,6 +513,28 @@ type PullRequestsResponse struct {
 	PageInfo   PageInfo
 }
 
+// pullRequestsSearch is the result of a search for PRs
+type pullRequestsSearch struct {
+	Nodes []struct {
+		PullRequest PullRequestData `graphql:"... on PullRequest"`
+	}
+	IssueCount int
+	PageInfo   PageInfo
+}
+
+func (s pullRequestsSearch) response() PullRequestsResponse {
+	prs := make([]PullRequestData, 0, len(s.Nodes))
+	for _, node := range s.Nodes {
+		prs = append(prs, node.PullRequest)
+	}
+
+	return PullRequestsResponse{
+		Prs:        prs,
+		TotalCount: s.IssueCount,
+		PageInfo:   s.PageInfo,
+	}
+}
+
 var (
 	client       *gh.GraphQLClient
 	cachedClient *gh.GraphQLClient
@@ -493,10 +545,13 @@ func SetClient(c *gh.GraphQLClient) {
 	cachedClient = c
 }
 
-// ClearEnrichmentCache clears the cached GraphQL client used for fetching
-// enriched PR/Issue data. Call this when refreshing to ensure fresh data.
+// ClearEnrichmentCache clears the cached GraphQL client and the enriched
+// PR/Issue data fetched with it. Call this when refreshing to ensure fresh
+// data.
 func ClearEnrichmentCache() {
 	cachedClient = nil
+	enrichedPrs.purge()
+	enrichedIssues.purge()
 }
 
 // IsEnrichmentCacheCleared returns true if the enrichment cache is cleared.
@@ -505,42 +560,18 @@ func IsEnrichmentCacheCleared() bool {
 	return cachedClient == nil
 }
 
-func FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequestsResponse, error) {
-	var err error
-	if client == nil {
-		if config.IsFeatureEnabled(config.FF_MOCK_DATA) {
-			log.Info("using mock data", "server", "https://localhost:3000")
-			http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{
-				InsecureSkipVerify: true,
-			}
-			client, err = gh.NewGraphQLClient(
-				gh.ClientOptions{Host: "localhost:3000", AuthToken: "fake-token"},
-			)
-		} else {
-			level := os.Getenv("LOG_LEVEL")
-			opts := gh.ClientOptions{}
-			if level == "debug" {
-				logger := NewHTTPLogger(0)
-				opts.Log = &logger
-				opts.LogVerboseHTTP = true
-				opts.LogColorize = true
-			}
-			client, err = gh.NewGraphQLClient(opts)
-		}
+func FetchPullRequests(query string, limit int, pageInfo *PageInfo, host string) (PullRequestsResponse, error) {
+	if err := checkRateLimit(host, RateLimitGraphQL); err != nil {
+		return PullRequestsResponse{}, err
 	}
-
+	client, err := graphQLClientForHost(host)
 	if err != nil {
 		return PullRequestsResponse{}, err
 	}
 
 	var queryResult struct {
-		Search struct {
-			Nodes []struct {
-				PullRequest PullRequestData `graphql:"... on PullRequest"`
-			}
-			IssueCount int
-			PageInfo   PageInfo
-		} `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
+		Search    pullRequestsSearch `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
+		RateLimit graphQLRateLimit   `graphql:"rateLimit"`
 	}
 	var endCursor *string
 	if pageInfo != nil {
@@ -554,29 +585,76 @@ func FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequest
 	log.Debug("Fetching PRs", "query", query, "limit", limit, "endCursor", endCursor)
 	err = client.Query("SearchPullRequests", &queryResult, variables)
 	if err != nil {
-		return PullRequestsResponse{}, err
+		return PullRequestsResponse{}, explainRateLimitError(host, RateLimitGraphQL, err)
 	}
+	recordGraphQLRateLimit(host, queryResult.RateLimit)
 	log.Info("Successfully fetched PRs", "count", queryResult.Search.IssueCount)
 
-	prs := make([]PullRequestData, 0, len(queryResult.Search.Nodes))
-	for _, node := range queryResult.Search.Nodes {
-		prs = append(prs, node.PullRequest)
+	return queryResult.Search.response(), nil
+}
+
+// FetchRequestedReviewers returns who the reviews of the PRs a search finds
+// are requested from, by the url of the PR. It's only fetched for the
+// sections that notify about review requests so the other searches don't pay
+// for it.
+func FetchRequestedReviewers(
+	query string,
+	limit int,
+	host string,
+) (map[string]ReviewRequests, error) {
+	if err := checkRateLimit(host, RateLimitGraphQL); err != nil {
+		return nil, err
+	}
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return nil, err
 	}
 
-	return PullRequestsResponse{
-		Prs:        prs,
-		TotalCount: queryResult.Search.IssueCount,
-		PageInfo:   queryResult.Search.PageInfo,
-	}, nil
+	var queryResult struct {
+		Search struct {
+			Nodes []struct {
+				PullRequest struct {
+					Url            string
+					ReviewRequests ReviewRequests `graphql:"reviewRequests(first: 20)"`
+				} `graphql:"... on PullRequest"`
+			}
+		} `graphql:"search(type: ISSUE, first: $limit, query: $query)"`
+		RateLimit graphQLRateLimit `graphql:"rateLimit"`
+	}
+	variables := map[string]any{
+		"query": graphql.String(makePullRequestsQuery(query)),
+		"limit": graphql.Int(limit),
+	}
+	log.Debug("Fetching requested reviewers", "query", query, "limit", limit)
+	err = client.Query("SearchRequestedReviewers", &queryResult, variables)
+	if err != nil {
+		return nil, explainRateLimitError(host, RateLimitGraphQL, err)
+	}
+	recordGraphQLRateLimit(host, queryResult.RateLimit)
+
+	reviewers := make(map[string]ReviewRequests, len(queryResult.Search.Nodes))
+	for _, node := range queryResult.Search.Nodes {
+		reviewers[node.PullRequest.Url] = node.PullRequest.ReviewRequests
+	}
+	return reviewers, nil
 }
 
+// FetchPullRequest fetches the enriched data of the PR at prUrl and keeps it
+// in the enrichment cache
 func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
-	var err error
-	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
-		if err != nil {
-			return EnrichedPullRequestData{}, err
+	return prFetches.do(prUrl, func() (EnrichedPullRequestData, error) {
+		pr, err := fetchPullRequest(prUrl)
+		if err == nil {
+			enrichedPrs.put(prUrl, pr.UpdatedAt, pr)
 		}
+		return pr, err
+	})
+}
+
+func fetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
+	client, err := graphQLClientForHost(HostOf(prUrl))
+	if err != nil {
+		return EnrichedPullRequestData{}, err
 	}
 
 	var queryResult struct {
@@ -600,3 +678,31 @@ func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
 
 	return queryResult.Resource.PullRequest, nil
 }
+
+// FetchCheckRunAnnotations fetches the annotations a check run reported.
+// They're fetched separately from the PR as they're only shown for a few
+// check runs and would make every PR query a lot more costly.
+func FetchCheckRunAnnotations(checkRunId string, host string) (CheckAnnotations, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return CheckAnnotations{}, err
+	}
+
+	var queryResult struct {
+		Node struct {
+			CheckRun struct {
+				Annotations CheckAnnotations `graphql:"annotations(first: 30)"`
+			} `graphql:"... on CheckRun"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(checkRunId),
+	}
+	log.Debug("Fetching check run annotations", "id", checkRunId)
+	err = client.Query("FetchCheckRunAnnotations", &queryResult, variables)
+	if err != nil {
+		return CheckAnnotations{}, err
+	}
+
+	return queryResult.Node.CheckRun.Annotations, nil
+}
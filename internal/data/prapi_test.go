@@ -78,3 +78,78 @@ func TestSetClient(t *testing.T) {
 		require.True(t, IsEnrichmentCacheCleared())
 	})
 }
+
+func TestFetchCheckRunAnnotations(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	const host = "ghe.annotations.test"
+	stub := &graphQLStub{response: `{"data": {"node": {"annotations": {
+		"totalCount": 2,
+		"nodes": [{"path": "main.go", "annotationLevel": "FAILURE", "message": "undefined: foo",
+			"location": {"start": {"line": 3}, "end": {"line": 3}}}]
+	}}}}`}
+	client, err := gh.NewGraphQLClient(gh.ClientOptions{
+		Host:      host,
+		AuthToken: "fake-token",
+		Transport: stub,
+	})
+	require.NoError(t, err)
+	hostClientsMu.Lock()
+	hostGraphQLClients[host] = client
+	hostClientsMu.Unlock()
+	t.Cleanup(func() {
+		hostClientsMu.Lock()
+		delete(hostGraphQLClients, host)
+		hostClientsMu.Unlock()
+	})
+
+	annotations, err := FetchCheckRunAnnotations("CR_1", host)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, annotations.TotalCount)
+	require.Len(t, annotations.Nodes, 1)
+	require.EqualValues(t, "main.go", annotations.Nodes[0].Path)
+	require.EqualValues(t, 3, annotations.Nodes[0].Location.Start.Line)
+
+	require.Len(t, stub.requests, 1)
+	require.Contains(t, stub.requests[0].Query, "query FetchCheckRunAnnotations($id:ID!)")
+	require.Contains(t, stub.requests[0].Query, "node(id: $id)")
+	require.Equal(t, "CR_1", stub.requests[0].Variables["id"])
+}
+
+func TestFetchRequestedReviewers(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	const host = "ghe.reviewers.test"
+	stub := &graphQLStub{response: `{"data": {
+		"search": {"nodes": [{
+			"url": "https://ghe.reviewers.test/owner/repo/pull/1",
+			"reviewRequests": {"totalCount": 1, "nodes": [{"requestedReviewer": {"login": "me"}}]}
+		}]},
+		"rateLimit": {"limit": 5000, "cost": 1, "remaining": 4000, "resetAt": "2099-01-01T00:00:00Z"}
+	}}`}
+	client, err := gh.NewGraphQLClient(gh.ClientOptions{
+		Host:      host,
+		AuthToken: "fake-token",
+		Transport: stub,
+	})
+	require.NoError(t, err)
+	hostClientsMu.Lock()
+	hostGraphQLClients[host] = client
+	hostClientsMu.Unlock()
+	t.Cleanup(func() {
+		hostClientsMu.Lock()
+		delete(hostGraphQLClients, host)
+		hostClientsMu.Unlock()
+	})
+
+	reviewers, err := FetchRequestedReviewers("review-requested:@me", 20, host)
+	require.NoError(t, err)
+	require.Len(t, reviewers, 1)
+	pr := reviewers["https://ghe.reviewers.test/owner/repo/pull/1"]
+	require.Len(t, pr.Nodes, 1)
+	require.Equal(t, "me", pr.Nodes[0].RequestedReviewer.User.Login)
+
+	require.Len(t, stub.requests, 1)
+	require.Contains(t, stub.requests[0].Query, "reviewRequests(first: 20)")
+	require.Equal(t, makePullRequestsQuery("review-requested:@me"), stub.requests[0].Variables["query"])
+}
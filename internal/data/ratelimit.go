@@ -0,0 +1,255 @@
+package data
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+)
+
+// The rate limit resources the dashboard spends its budget on
+const (
+	RateLimitGraphQL = "graphql"
+	RateLimitCore    = "core"
+)
+
+// RateLimit is the budget left of one of the rate limit resources of a host,
+// as last reported by GitHub
+type RateLimit struct {
+	// Host is empty for the default host
+	Host      string
+	Resource  string
+	Limit     int
+	Remaining int
+	// Cost is the cost of the last GraphQL query, it's unknown for REST
+	Cost    int
+	ResetAt time.Time
+}
+
+// graphQLRateLimit is queried along with the searches of the sections
+type graphQLRateLimit struct {
+	Limit     int
+	Cost      int
+	Remaining int
+	ResetAt   time.Time
+}
+
+type rateLimitKey struct {
+	host     string
+	resource string
+}
+
+var (
+	rateLimitsMu sync.Mutex
+	rateLimits   = make(map[rateLimitKey]RateLimit)
+)
+
+func recordRateLimit(rl RateLimit) {
+	if rl.Limit <= 0 {
+		return
+	}
+
+	rateLimitsMu.Lock()
+	defer rateLimitsMu.Unlock()
+	key := rateLimitKey{host: rl.Host, resource: rl.Resource}
+	// REST responses don't report the cost of the last GraphQL query
+	if rl.Cost == 0 {
+		rl.Cost = rateLimits[key].Cost
+	}
+	rateLimits[key] = rl
+}
+
+func recordGraphQLRateLimit(host string, rl graphQLRateLimit) {
+	recordRateLimit(RateLimit{
+		Host:      hostKey(host),
+		Resource:  RateLimitGraphQL,
+		Limit:     rl.Limit,
+		Remaining: rl.Remaining,
+		Cost:      rl.Cost,
+		ResetAt:   rl.ResetAt,
+	})
+}
+
+// recordRateLimitHeaders records the budget reported by the X-RateLimit
+// headers of a response. GitHub Enterprise Server instances with rate
+// limiting disabled don't send them.
+func recordRateLimitHeaders(host string, header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	resource := header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = RateLimitCore
+	}
+
+	recordRateLimit(RateLimit{
+		Host:      host,
+		Resource:  resource,
+		Limit:     limit,
+		Remaining: remaining,
+		ResetAt:   time.Unix(reset, 0),
+	})
+}
+
+// rateLimitTransport records the budget GitHub reports with every response
+type rateLimitTransport struct {
+	host string
+	base http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err == nil {
+		recordRateLimitHeaders(t.host, res.Header)
+	}
+	return res, err
+}
+
+// RateLimits returns the budgets last reported by GitHub, ordered by host and
+// resource
+func RateLimits() []RateLimit {
+	rateLimitsMu.Lock()
+	defer rateLimitsMu.Unlock()
+
+	res := make([]RateLimit, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		res = append(res, rl)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Host != res[j].Host {
+			return res[i].Host < res[j].Host
+		}
+		return res[i].Resource < res[j].Resource
+	})
+	return res
+}
+
+// LowestRateLimit returns the budget with the smallest share left. Budgets
+// that have reset since they were reported aren't considered.
+func LowestRateLimit(now time.Time) (RateLimit, bool) {
+	var lowest RateLimit
+	found := false
+	for _, rl := range RateLimits() {
+		if !now.Before(rl.ResetAt) {
+			continue
+		}
+		if !found || rl.share() < lowest.share() {
+			lowest = rl
+			found = true
+		}
+	}
+	return lowest, found
+}
+
+func (r RateLimit) share() float64 {
+	if r.Limit <= 0 {
+		return 1
+	}
+	return float64(r.Remaining) / float64(r.Limit)
+}
+
+// IsLow is true once less than a quarter of the budget is left
+func (r RateLimit) IsLow() bool {
+	return r.share() < 0.25
+}
+
+// IsCritical is true once less than a tenth of the budget is left
+func (r RateLimit) IsCritical() bool {
+	return r.share() < 0.1
+}
+
+// IsExhausted is true when nothing is left of the budget until it resets
+func (r RateLimit) IsExhausted(now time.Time) bool {
+	return r.Limit > 0 && r.Remaining <= 0 && now.Before(r.ResetAt)
+}
+
+// RefetchDelay returns how long a periodic refetch should wait given the
+// budget: the interval while there's enough of it, twice the interval once
+// it runs low and until it resets when it's nearly exhausted
+func (r RateLimit) RefetchDelay(interval time.Duration, now time.Time) time.Duration {
+	if !now.Before(r.ResetAt) {
+		return interval
+	}
+	switch {
+	case r.IsCritical():
+		return max(interval, r.ResetAt.Sub(now))
+	case r.IsLow():
+		return 2 * interval
+	default:
+		return interval
+	}
+}
+
+// RefetchDelay stretches the interval of a periodic refetch according to the
+// lowest budget left, so the refetches don't exhaust it
+func RefetchDelay(interval time.Duration) time.Duration {
+	now := time.Now()
+	rl, ok := LowestRateLimit(now)
+	if !ok {
+		return interval
+	}
+
+	delay := rl.RefetchDelay(interval, now)
+	if delay != interval {
+		log.Info("Backing off refetching as the rate limit budget is low",
+			"host", rl.Host, "resource", rl.Resource, "remaining", rl.Remaining,
+			"interval", interval, "delay", delay)
+	}
+	return delay
+}
+
+// RateLimitError is returned instead of sending requests to a host whose
+// budget is exhausted, until it resets
+type RateLimitError struct {
+	Host     string
+	Resource string
+	ResetAt  time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	host := e.Host
+	if host == "" {
+		host = "GitHub"
+	}
+	return fmt.Sprintf("%s %s API rate limit exhausted, resets in %s",
+		host, e.Resource, max(0, time.Until(e.ResetAt)).Round(time.Second))
+}
+
+// checkRateLimit returns a RateLimitError when the budget of the resource of
+// host is exhausted
+func checkRateLimit(host, resource string) error {
+	host = hostKey(host)
+	rateLimitsMu.Lock()
+	rl, ok := rateLimits[rateLimitKey{host: host, resource: resource}]
+	rateLimitsMu.Unlock()
+	if !ok || !rl.IsExhausted(time.Now()) {
+		return nil
+	}
+	return &RateLimitError{Host: host, Resource: resource, ResetAt: rl.ResetAt}
+}
+
+// explainRateLimitError replaces the error of a failed request with a
+// RateLimitError when it failed because the budget ran out
+func explainRateLimitError(host, resource string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if rateLimitErr := checkRateLimit(host, resource); rateLimitErr != nil {
+		log.Debug("Request failed with an exhausted rate limit", "host", host, "err", err)
+		return rateLimitErr
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func resetRateLimits(t *testing.T) {
+	t.Helper()
+	rateLimitsMu.Lock()
+	rateLimits = make(map[rateLimitKey]RateLimit)
+	rateLimitsMu.Unlock()
+	t.Cleanup(func() {
+		rateLimitsMu.Lock()
+		rateLimits = make(map[rateLimitKey]RateLimit)
+		rateLimitsMu.Unlock()
+	})
+}
+
+func TestRecordRateLimitHeaders(t *testing.T) {
+	resetRateLimits(t)
+	resetAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "5000")
+	header.Set("X-RateLimit-Remaining", "4321")
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+	header.Set("X-RateLimit-Resource", "graphql")
+	recordRateLimitHeaders("", header)
+
+	// Responses of hosts without rate limiting are ignored
+	recordRateLimitHeaders("ghe.example.com", http.Header{})
+
+	require.Equal(t, []RateLimit{{
+		Resource:  RateLimitGraphQL,
+		Limit:     5000,
+		Remaining: 4321,
+		ResetAt:   resetAt,
+	}}, RateLimits())
+
+	// The cost of the last query is kept until another query reports it
+	recordGraphQLRateLimit("", graphQLRateLimit{
+		Limit: 5000, Cost: 3, Remaining: 4318, ResetAt: resetAt,
+	})
+	recordRateLimitHeaders("", header)
+	require.Equal(t, 3, RateLimits()[0].Cost)
+}
+
+func TestLowestRateLimit(t *testing.T) {
+	resetRateLimits(t)
+	now := time.Now()
+
+	_, ok := LowestRateLimit(now)
+	require.False(t, ok)
+
+	recordRateLimit(RateLimit{
+		Resource: RateLimitGraphQL, Limit: 5000, Remaining: 1000, ResetAt: now.Add(time.Hour),
+	})
+	recordRateLimit(RateLimit{
+		Resource: RateLimitCore, Limit: 5000, Remaining: 4000, ResetAt: now.Add(time.Hour),
+	})
+	recordRateLimit(RateLimit{
+		Host:     "ghe.example.com",
+		Resource: RateLimitGraphQL, Limit: 5000, Remaining: 0, ResetAt: now.Add(-time.Minute),
+	})
+
+	lowest, ok := LowestRateLimit(now)
+	require.True(t, ok)
+	require.Equal(t, RateLimitGraphQL, lowest.Resource)
+	require.Equal(t, "", lowest.Host, "budgets that have reset should be skipped")
+}
+
+func TestRateLimit_RefetchDelay(t *testing.T) {
+	now := time.Now()
+	interval := 5 * time.Minute
+
+	tests := []struct {
+		name      string
+		remaining int
+		resetIn   time.Duration
+		want      time.Duration
+	}{
+		{name: "enough budget", remaining: 4000, resetIn: time.Hour, want: interval},
+		{name: "low budget", remaining: 1000, resetIn: time.Hour, want: 2 * interval},
+		{name: "critical budget", remaining: 100, resetIn: 40 * time.Minute, want: 40 * time.Minute},
+		{name: "critical budget resetting soon", remaining: 0, resetIn: time.Minute, want: interval},
+		{name: "reset budget", remaining: 0, resetIn: -time.Minute, want: interval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rl := RateLimit{Limit: 5000, Remaining: tt.remaining, ResetAt: now.Add(tt.resetIn)}
+			require.Equal(t, tt.want, rl.RefetchDelay(interval, now))
+		})
+	}
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	resetRateLimits(t)
+	t.Setenv("GH_HOST", "github.com")
+
+	require.NoError(t, checkRateLimit("", RateLimitGraphQL))
+
+	recordRateLimit(RateLimit{
+		Resource: RateLimitGraphQL, Limit: 5000, Remaining: 0, ResetAt: time.Now().Add(time.Hour),
+	})
+
+	var rateLimitErr *RateLimitError
+	require.ErrorAs(t, checkRateLimit("", RateLimitGraphQL), &rateLimitErr)
+	require.ErrorAs(t, checkRateLimit("github.com", RateLimitGraphQL), &rateLimitErr,
+		"the default host should share its budget whichever way it's named")
+	require.NoError(t, checkRateLimit("", RateLimitCore))
+	require.NoError(t, checkRateLimit("ghe.example.com", RateLimitGraphQL))
+
+	err := explainRateLimitError("", RateLimitGraphQL, errors.New("API rate limit exceeded"))
+	require.ErrorAs(t, err, &rateLimitErr)
+	require.Contains(t, err.Error(), "GitHub graphql API rate limit exhausted")
+}
@@ -0,0 +1,354 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"charm.land/log/v2"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// PullRequestFile is a changed file as returned by the REST API, which unlike
+// the GraphQL API includes the patch of the file.
+type PullRequestFile struct {
+	Filename  string `json:"filename"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Patch     string `json:"patch"`
+}
+
+// NewReviewThread describes a review comment on a line or a range of lines.
+// StartLine is 0 for single line comments.
+type NewReviewThread struct {
+	Path      string
+	Body      string
+	Line      int
+	Side      DiffSide
+	StartLine int
+	StartSide DiffSide
+}
+
+var ErrPendingReviewExists = errors.New(
+	"you already have a pending review on this PR, add the comment to it instead",
+)
+
+func FetchPullRequestFiles(repoNameWithOwner string, prNumber int, host string) ([]PullRequestFile, error) {
+	client, err := restClientForHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []PullRequestFile
+	path := fmt.Sprintf("repos/%s/pulls/%d/files?per_page=100", repoNameWithOwner, prNumber)
+	log.Debug("Fetching PR files", "repo", repoNameWithOwner, "pr", prNumber)
+	for path != "" {
+		res, err := client.Request(http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page []PullRequestFile
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, page...)
+		path = nextPageUrl(res.Header.Get("Link"))
+	}
+
+	return files, nil
+}
+
+var linkNextRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// nextPageUrl returns the URL of the next page from the Link header of a REST
+// response, or "" on the last page
+func nextPageUrl(link string) string {
+	m := linkNextRegex.FindStringSubmatch(link)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// ReviewEvent is how a review is submitted
+type ReviewEvent string
+
+const (
+	ReviewEventApprove        ReviewEvent = "APPROVE"
+	ReviewEventRequestChanges ReviewEvent = "REQUEST_CHANGES"
+	ReviewEventComment        ReviewEvent = "COMMENT"
+)
+
+// PendingReview is a review the viewer started but didn't submit yet
+type PendingReview struct {
+	Id       string
+	Comments struct {
+		TotalCount int
+	}
+}
+
+// FetchPendingReview returns the viewer's pending review on the PR, or nil if
+// there is none. Pending reviews are only visible to their author so any
+// pending review returned belongs to the viewer.
+func FetchPendingReview(prId string, host string) (*PendingReview, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryResult struct {
+		Node struct {
+			PullRequest struct {
+				Reviews struct {
+					Nodes []PendingReview
+				} `graphql:"reviews(first: 1, states: PENDING)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": prId,
+	}
+	err = client.Query("FetchPendingReview", &queryResult, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(queryResult.Node.PullRequest.Reviews.Nodes) == 0 {
+		return nil, nil
+	}
+	return &queryResult.Node.PullRequest.Reviews.Nodes[0], nil
+}
+
+func createPendingReview(client *gh.GraphQLClient, prId string) (string, error) {
+	var mutation struct {
+		AddPullRequestReview struct {
+			PullRequestReview struct {
+				Id string
+			}
+		} `graphql:"addPullRequestReview(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.AddPullRequestReviewInput{PullRequestID: prId},
+	}
+	if err := client.Mutate("AddPullRequestReview", &mutation, variables); err != nil {
+		return "", err
+	}
+	return mutation.AddPullRequestReview.PullRequestReview.Id, nil
+}
+
+// AddPullRequestReviewThread adds a review thread to the viewer's pending
+// review, creating the review if needed. When submit is true the review is
+// submitted right away, so the thread is posted as a single comment.
+func AddPullRequestReviewThread(
+	prId string,
+	thread NewReviewThread,
+	submit bool,
+	host string,
+) (ReviewThread, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return ReviewThread{}, err
+	}
+	pending, err := FetchPendingReview(prId, host)
+	if err != nil {
+		return ReviewThread{}, err
+	}
+
+	if pending != nil && submit {
+		return ReviewThread{}, ErrPendingReviewExists
+	}
+
+	var reviewId string
+	if pending != nil {
+		reviewId = pending.Id
+	} else {
+		reviewId, err = createPendingReview(client, prId)
+		if err != nil {
+			return ReviewThread{}, err
+		}
+	}
+
+	input := githubv4.AddPullRequestReviewThreadInput{
+		Body:                githubv4.String(thread.Body),
+		Path:                githubv4.NewString(githubv4.String(thread.Path)),
+		PullRequestReviewID: githubv4.NewID(reviewId),
+		Line:                githubv4.NewInt(githubv4.Int(thread.Line)),
+		Side:                diffSidePtr(thread.Side),
+	}
+	if thread.StartLine != 0 && thread.StartLine != thread.Line {
+		input.StartLine = githubv4.NewInt(githubv4.Int(thread.StartLine))
+		input.StartSide = diffSidePtr(thread.StartSide)
+	}
+
+	var mutation struct {
+		AddPullRequestReviewThread struct {
+			Thread ReviewThread
+		} `graphql:"addPullRequestReviewThread(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": input,
+	}
+	log.Debug("Adding review thread", "pr", prId, "path", thread.Path, "line", thread.Line)
+	err = client.Mutate("AddPullRequestReviewThread", &mutation, variables)
+	if err != nil {
+		return ReviewThread{}, err
+	}
+
+	if submit {
+		if err := submitPendingReview(client, reviewId, ReviewEventComment, ""); err != nil {
+			return ReviewThread{}, err
+		}
+	}
+
+	return mutation.AddPullRequestReviewThread.Thread, nil
+}
+
+// SubmitReview submits a review on the PR. If the viewer has a pending
+// review, it's submitted along with all of its inline comments.
+func SubmitReview(prId string, event ReviewEvent, body string, host string) error {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return err
+	}
+	pending, err := FetchPendingReview(prId, host)
+	if err != nil {
+		return err
+	}
+
+	if pending != nil {
+		return submitPendingReview(client, pending.Id, event, body)
+	}
+
+	githubEvent := githubv4.PullRequestReviewEvent(event)
+	input := githubv4.AddPullRequestReviewInput{
+		PullRequestID: prId,
+		Event:         &githubEvent,
+	}
+	if body != "" {
+		input.Body = githubv4.NewString(githubv4.String(body))
+	}
+
+	var mutation struct {
+		AddPullRequestReview struct {
+			PullRequestReview struct {
+				Id string
+			}
+		} `graphql:"addPullRequestReview(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": input,
+	}
+	log.Debug("Submitting review", "pr", prId, "event", event)
+	return client.Mutate("AddPullRequestReview", &mutation, variables)
+}
+
+func submitPendingReview(
+	client *gh.GraphQLClient,
+	reviewId string,
+	event ReviewEvent,
+	body string,
+) error {
+	input := githubv4.SubmitPullRequestReviewInput{
+		Event:               githubv4.PullRequestReviewEvent(event),
+		PullRequestReviewID: githubv4.NewID(reviewId),
+	}
+	if body != "" {
+		input.Body = githubv4.NewString(githubv4.String(body))
+	}
+
+	var mutation struct {
+		SubmitPullRequestReview struct {
+			PullRequestReview struct {
+				Id string
+			}
+		} `graphql:"submitPullRequestReview(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": input,
+	}
+	log.Debug("Submitting pending review", "review", reviewId, "event", event)
+	return client.Mutate("SubmitPullRequestReview", &mutation, variables)
+}
+
+// ReviewThreadReply is a comment added to an existing review thread
+type ReviewThreadReply struct {
+	ThreadId string
+	Comment  ReviewComment
+}
+
+// ReplyToReviewThread posts a reply to a review thread. The reply is added to
+// the viewer's pending review if they have one, and posted right away
+// otherwise.
+func ReplyToReviewThread(threadId string, body string, host string) (ReviewThreadReply, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return ReviewThreadReply{}, err
+	}
+
+	var mutation struct {
+		AddPullRequestReviewThreadReply struct {
+			Comment ReviewComment
+		} `graphql:"addPullRequestReviewThreadReply(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.AddPullRequestReviewThreadReplyInput{
+			PullRequestReviewThreadID: threadId,
+			Body:                      githubv4.String(body),
+		},
+	}
+	log.Debug("Replying to review thread", "thread", threadId)
+	err = client.Mutate("AddPullRequestReviewThreadReply", &mutation, variables)
+	if err != nil {
+		return ReviewThreadReply{}, err
+	}
+
+	return ReviewThreadReply{
+		ThreadId: threadId,
+		Comment:  mutation.AddPullRequestReviewThreadReply.Comment,
+	}, nil
+}
+
+// SetReviewThreadResolved resolves or unresolves a review thread and returns
+// the updated thread
+func SetReviewThreadResolved(threadId string, resolved bool, host string) (ReviewThread, error) {
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return ReviewThread{}, err
+	}
+
+	log.Debug("Setting review thread resolved", "thread", threadId, "resolved", resolved)
+	if resolved {
+		var mutation struct {
+			ResolveReviewThread struct {
+				Thread ReviewThread
+			} `graphql:"resolveReviewThread(input: $input)"`
+		}
+		variables := map[string]any{
+			"input": githubv4.ResolveReviewThreadInput{ThreadID: threadId},
+		}
+		err = client.Mutate("ResolveReviewThread", &mutation, variables)
+		return mutation.ResolveReviewThread.Thread, err
+	}
+
+	var mutation struct {
+		UnresolveReviewThread struct {
+			Thread ReviewThread
+		} `graphql:"unresolveReviewThread(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": githubv4.UnresolveReviewThreadInput{ThreadID: threadId},
+	}
+	err = client.Mutate("UnresolveReviewThread", &mutation, variables)
+	return mutation.UnresolveReviewThread.Thread, err
+}
+
+func diffSidePtr(side DiffSide) *githubv4.DiffSide {
+	s := githubv4.DiffSide(side)
+	return &s
+}
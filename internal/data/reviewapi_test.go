@@ -0,0 +1,81 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedFilesStub serves the files of a PR in pages of one file, linking each
+// page to the next one like the REST API does
+type pagedFilesStub struct {
+	files []string
+	urls  []string
+}
+
+func (s *pagedFilesStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.urls = append(s.urls, req.URL.String())
+	page := 0
+	if p := req.URL.Query().Get("page"); p != "" {
+		fmt.Sscan(p, &page)
+	}
+
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	if page+1 < len(s.files) {
+		next := *req.URL
+		q := next.Query()
+		q.Set("page", fmt.Sprint(page+1))
+		next.RawQuery = q.Encode()
+		header.Set("Link", fmt.Sprintf(`<%s>; rel="next", <%s>; rel="last"`, next.String(), next.String()))
+	}
+	body := fmt.Sprintf(`[{"filename": %q, "status": "modified"}]`, s.files[page])
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestFetchPullRequestFilesFollowsPages(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	const host = "ghe.files.test"
+	stub := &pagedFilesStub{files: []string{"a.go", "b.go", "c.go"}}
+	client, err := gh.NewRESTClient(gh.ClientOptions{
+		Host:      host,
+		AuthToken: "fake-token",
+		Transport: stub,
+	})
+	require.NoError(t, err)
+	hostClientsMu.Lock()
+	hostRESTClients[host] = client
+	hostClientsMu.Unlock()
+	t.Cleanup(func() {
+		hostClientsMu.Lock()
+		delete(hostRESTClients, host)
+		hostClientsMu.Unlock()
+	})
+
+	files, err := FetchPullRequestFiles("owner/repo", 1, host)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, "c.go", files[2].Filename)
+	require.Len(t, stub.urls, 3)
+	require.Contains(t, stub.urls[2], "page=2")
+}
+
+func TestNextPageUrl(t *testing.T) {
+	require.Equal(t,
+		"https://api.github.com/repositories/1/pulls/1/files?per_page=100&page=2",
+		nextPageUrl(`<https://api.github.com/repositories/1/pulls/1/files?per_page=100&page=2>; rel="next", `+
+			`<https://api.github.com/repositories/1/pulls/1/files?per_page=100&page=3>; rel="last"`),
+	)
+	require.Empty(t, nextPageUrl(`<https://api.github.com/repositories/1/pulls/1/files?page=1>; rel="prev"`))
+	require.Empty(t, nextPageUrl(""))
+}
@@ -0,0 +1,239 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"charm.land/log/v2"
+	graphql "github.com/cli/shurcooL-graphql"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// maxBatchedResults caps how many results a batched request asks for. The
+// nodes of the searches are expensive, so asking for more in one request
+// would go over the complexity limits of the API.
+const maxBatchedResults = 100
+
+// SearchRequest is a search for the first page of a section
+type SearchRequest struct {
+	Query string
+	Limit int
+	// Host is empty for the default host
+	Host string
+}
+
+// searchConnection is the result of a search that can be aliased in a query
+// along with other searches
+type searchConnection[R any] interface {
+	response() R
+}
+
+// ErrSearchBatchStarted is returned when adding a search to a batch whose
+// requests were already sent, the search should be fetched on its own
+var ErrSearchBatchStarted = errors.New("the batch is already being fetched")
+
+// SearchBatch fetches the first page of the searches of many sections in as
+// few requests as possible, aliasing the searches of the same host into one
+// query. All the searches are added before the first result is asked for,
+// which sends the request of its chunk.
+type SearchBatch[R any] struct {
+	// fetch returns the result or the error of each of the requests
+	fetch func(host string, requests []SearchRequest) ([]R, []error)
+
+	mu       sync.Mutex
+	requests []SearchRequest
+	chunks   []*searchChunk[R]
+	chunkOf  []int
+}
+
+type searchChunk[R any] struct {
+	once    sync.Once
+	indices []int
+	results []R
+	errs    []error
+}
+
+// Add adds a search to the batch and returns its index, or
+// ErrSearchBatchStarted once a result was asked for
+func (b *SearchBatch[R]) Add(request SearchRequest) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.chunks != nil {
+		return -1, ErrSearchBatchStarted
+	}
+	b.requests = append(b.requests, request)
+	return len(b.requests) - 1, nil
+}
+
+// Result returns the result of the search at index i, fetching it along with
+// the other searches of its chunk unless it already was
+func (b *SearchBatch[R]) Result(i int) (R, error) {
+	b.mu.Lock()
+	if b.chunks == nil {
+		maxResults := maxBatchedResults
+		// The recorded fixtures are of single searches
+		if config.IsFeatureEnabled(config.FF_MOCK_DATA) {
+			maxResults = 0
+		}
+		b.chunkOf = make([]int, len(b.requests))
+		for c, indices := range chunkSearches(b.requests, maxResults) {
+			b.chunks = append(b.chunks, &searchChunk[R]{indices: indices})
+			for _, index := range indices {
+				b.chunkOf[index] = c
+			}
+		}
+	}
+	chunk := b.chunks[b.chunkOf[i]]
+	b.mu.Unlock()
+
+	chunk.once.Do(func() {
+		requests := make([]SearchRequest, 0, len(chunk.indices))
+		for _, index := range chunk.indices {
+			requests = append(requests, b.requests[index])
+		}
+		chunk.results, chunk.errs = b.fetch(requests[0].Host, requests)
+	})
+
+	var res R
+	for pos, index := range chunk.indices {
+		if index == i {
+			if err := chunk.errs[pos]; err != nil {
+				return res, err
+			}
+			res = chunk.results[pos]
+		}
+	}
+	return res, nil
+}
+
+// chunkSearches splits the searches into the chunks each request fetches.
+// Only searches of the same host share a chunk, and a chunk only has more
+// than one search while their limits add up to maxResults at most.
+func chunkSearches(requests []SearchRequest, maxResults int) [][]int {
+	chunks := make([][]int, 0)
+	open := make(map[string]int)
+	results := make(map[string]int)
+	for i, request := range requests {
+		host := hostKey(request.Host)
+		c, ok := open[host]
+		if !ok || results[host]+request.Limit > maxResults {
+			chunks = append(chunks, nil)
+			c = len(chunks) - 1
+			open[host] = c
+			results[host] = 0
+		}
+		chunks[c] = append(chunks[c], i)
+		results[host] += request.Limit
+	}
+	return chunks
+}
+
+// querySearches sends the searches in one query, aliasing each of them
+func querySearches[C searchConnection[R], R any](
+	operation string,
+	searchType string,
+	host string,
+	queries []string,
+	limits []int,
+) ([]R, error) {
+	if err := checkRateLimit(host, RateLimitGraphQL); err != nil {
+		return nil, err
+	}
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]reflect.StructField, 0, len(queries)+1)
+	variables := make(map[string]any, 2*len(queries))
+	for i, query := range queries {
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("Search%d", i),
+			Type: reflect.TypeFor[C](),
+			Tag: reflect.StructTag(fmt.Sprintf(
+				`graphql:"search%d: search(type: %s, first: $limit%d, query: $query%d)"`,
+				i, searchType, i, i)),
+		})
+		variables[fmt.Sprintf("query%d", i)] = graphql.String(query)
+		variables[fmt.Sprintf("limit%d", i)] = graphql.Int(limits[i])
+	}
+	fields = append(fields, reflect.StructField{
+		Name: "RateLimit",
+		Type: reflect.TypeFor[graphQLRateLimit](),
+		Tag:  `graphql:"rateLimit"`,
+	})
+
+	queryResult := reflect.New(reflect.StructOf(fields))
+	log.Debug("Fetching batched searches", "operation", operation, "count", len(queries), "host", host)
+	err = client.Query(operation, queryResult.Interface(), variables)
+	if err != nil {
+		return nil, explainRateLimitError(host, RateLimitGraphQL, err)
+	}
+	recordGraphQLRateLimit(host, queryResult.Elem().Field(len(queries)).Interface().(graphQLRateLimit))
+	log.Info("Successfully fetched batched searches", "operation", operation, "count", len(queries))
+
+	res := make([]R, 0, len(queries))
+	for i := range queries {
+		res = append(res, queryResult.Elem().Field(i).Interface().(C).response())
+	}
+	return res, nil
+}
+
+// newSearchBatch creates a batch fetching chunks of one search with fetchOne,
+// the same way a section fetches on its own, and larger ones in one query.
+// When the query fails, e.g. as one of the searches is of a repo the user
+// can't see, its searches are fetched one by one so only the failing ones
+// fail.
+func newSearchBatch[C searchConnection[R], R any](
+	operation string,
+	searchType string,
+	makeQuery func(string) string,
+	fetchOne func(query string, limit int, pageInfo *PageInfo, host string) (R, error),
+) *SearchBatch[R] {
+	return &SearchBatch[R]{
+		fetch: func(host string, requests []SearchRequest) ([]R, []error) {
+			if len(requests) > 1 {
+				queries := make([]string, 0, len(requests))
+				limits := make([]int, 0, len(requests))
+				for _, request := range requests {
+					queries = append(queries, makeQuery(request.Query))
+					limits = append(limits, request.Limit)
+				}
+				res, err := querySearches[C](operation, searchType, host, queries, limits)
+				if err == nil {
+					return res, make([]error, len(requests))
+				}
+				log.Warn("Failed fetching batched searches, fetching them one by one",
+					"operation", operation, "count", len(requests), "err", err)
+			}
+
+			res := make([]R, len(requests))
+			errs := make([]error, len(requests))
+			for i, request := range requests {
+				res[i], errs[i] = fetchOne(request.Query, request.Limit, nil, host)
+			}
+			return res, errs
+		},
+	}
+}
+
+// NewPullRequestsBatch creates a batch of PR searches
+func NewPullRequestsBatch() *SearchBatch[PullRequestsResponse] {
+	return newSearchBatch[pullRequestsSearch](
+		"SearchPullRequestsBatch", "ISSUE", makePullRequestsQuery, FetchPullRequests)
+}
+
+// NewIssuesBatch creates a batch of issue searches
+func NewIssuesBatch() *SearchBatch[IssuesResponse] {
+	return newSearchBatch[issuesSearch](
+		"SearchIssuesBatch", "ISSUE", makeIssuesQuery, FetchIssues)
+}
+
+// NewDiscussionsBatch creates a batch of discussion searches
+func NewDiscussionsBatch() *SearchBatch[DiscussionsResponse] {
+	return newSearchBatch[discussionsSearch](
+		"SearchDiscussionsBatch", "DISCUSSION", makeDiscussionsQuery, FetchDiscussions)
+}
@@ -0,0 +1,209 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkSearches(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	requests := []SearchRequest{
+		{Query: "a", Limit: 20},
+		{Query: "b", Limit: 50, Host: "ghe.example.com"},
+		{Query: "c", Limit: 30},
+		{Query: "d", Limit: 60},
+		{Query: "e", Limit: 50, Host: "ghe.example.com"},
+		{Query: "f", Limit: 40, Host: "github.com"},
+		{Query: "g", Limit: 150},
+	}
+
+	require.Equal(t, [][]int{{0, 2}, {1, 4}, {3, 5}, {6}}, chunkSearches(requests, 100))
+	require.Equal(t, [][]int{{0}, {1}, {2}, {3}, {4}, {5}, {6}}, chunkSearches(requests, 0))
+}
+
+// graphQLStub answers the GraphQL queries it receives with response, or
+// with what respond returns when it's set
+type graphQLStub struct {
+	mu       sync.Mutex
+	requests []graphQLStubRequest
+	response string
+	respond  func(request graphQLStubRequest) string
+}
+
+type graphQLStubRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+func (s *graphQLStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body graphQLStubRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, body)
+	s.mu.Unlock()
+
+	response := s.response
+	if s.respond != nil {
+		response = s.respond(body)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewBufferString(response)),
+		Request:    req,
+	}, nil
+}
+
+func TestPullRequestsBatch(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	const host = "ghe.batch.test"
+	stub := &graphQLStub{response: `{"data": {
+		"search0": {"issueCount": 1, "nodes": [{"number": 1, "title": "First"}]},
+		"search1": {"issueCount": 2, "nodes": [
+			{"number": 2, "title": "Second"},
+			{"number": 3, "title": "Third"}
+		]},
+		"rateLimit": {"limit": 5000, "cost": 2, "remaining": 4000, "resetAt": "2099-01-01T00:00:00Z"}
+	}}`}
+	client, err := gh.NewGraphQLClient(gh.ClientOptions{
+		Host:      host,
+		AuthToken: "fake-token",
+		Transport: stub,
+	})
+	require.NoError(t, err)
+	hostClientsMu.Lock()
+	hostGraphQLClients[host] = client
+	hostClientsMu.Unlock()
+	t.Cleanup(func() {
+		hostClientsMu.Lock()
+		delete(hostGraphQLClients, host)
+		hostClientsMu.Unlock()
+	})
+
+	batch := NewPullRequestsBatch()
+	first, err := batch.Add(SearchRequest{Query: "author:@me", Limit: 20, Host: host})
+	require.NoError(t, err)
+	second, err := batch.Add(SearchRequest{Query: "review-requested:@me", Limit: 20, Host: host})
+	require.NoError(t, err)
+
+	res, err := batch.Result(second)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.TotalCount)
+	require.Len(t, res.Prs, 2)
+	require.Equal(t, "Second", res.Prs[0].Title)
+
+	res, err = batch.Result(first)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.TotalCount)
+	require.Equal(t, "First", res.Prs[0].Title)
+
+	require.Len(t, stub.requests, 1, "searches of the same host should share a request")
+	request := stub.requests[0]
+	require.Contains(t, request.Query, "query SearchPullRequestsBatch(")
+	require.Contains(t, request.Query, "search0: search(type: ISSUE, first: $limit0, query: $query0)")
+	require.Contains(t, request.Query, "search1: search(type: ISSUE, first: $limit1, query: $query1)")
+	require.Equal(t, makePullRequestsQuery("author:@me"), request.Variables["query0"])
+	require.Equal(t, makePullRequestsQuery("review-requested:@me"), request.Variables["query1"])
+	require.EqualValues(t, 20, request.Variables["limit1"])
+}
+
+func TestSearchBatch_Errors(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	calls := 0
+	batch := &SearchBatch[int]{
+		fetch: func(host string, requests []SearchRequest) ([]int, []error) {
+			calls++
+			res := make([]int, 0, len(requests))
+			errs := make([]error, 0, len(requests))
+			for _, request := range requests {
+				res = append(res, request.Limit)
+				if host == "ghe.example.com" {
+					errs = append(errs, io.ErrUnexpectedEOF)
+				} else {
+					errs = append(errs, nil)
+				}
+			}
+			return res, errs
+		},
+	}
+	a, err := batch.Add(SearchRequest{Query: "a", Limit: 10})
+	require.NoError(t, err)
+	b, err := batch.Add(SearchRequest{Query: "b", Limit: 20, Host: "ghe.example.com"})
+	require.NoError(t, err)
+	c, err := batch.Add(SearchRequest{Query: "c", Limit: 30})
+	require.NoError(t, err)
+
+	res, err := batch.Result(c)
+	require.NoError(t, err)
+	require.Equal(t, 30, res)
+	res, err = batch.Result(a)
+	require.NoError(t, err)
+	require.Equal(t, 10, res)
+	_, err = batch.Result(b)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.Equal(t, 2, calls)
+
+	_, err = batch.Add(SearchRequest{Query: "d"})
+	require.ErrorIs(t, err, ErrSearchBatchStarted)
+}
+
+func TestPullRequestsBatch_FallsBackToSingleSearches(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	const host = "ghe.fallback.test"
+	stub := &graphQLStub{respond: func(request graphQLStubRequest) string {
+		query, _ := request.Variables["query"].(string)
+		switch {
+		case strings.Contains(request.Query, "SearchPullRequestsBatch"),
+			strings.Contains(query, "repo:hidden/repo"):
+			return `{"errors": [{"message": "Could not resolve to a Repository"}]}`
+		default:
+			return `{"data": {
+				"search": {"issueCount": 1, "nodes": [{"number": 1, "title": "Mine"}]},
+				"rateLimit": {"limit": 5000, "cost": 1, "remaining": 4000, "resetAt": "2099-01-01T00:00:00Z"}
+			}}`
+		}
+	}}
+	client, err := gh.NewGraphQLClient(gh.ClientOptions{
+		Host:      host,
+		AuthToken: "fake-token",
+		Transport: stub,
+	})
+	require.NoError(t, err)
+	hostClientsMu.Lock()
+	hostGraphQLClients[host] = client
+	hostClientsMu.Unlock()
+	t.Cleanup(func() {
+		hostClientsMu.Lock()
+		delete(hostGraphQLClients, host)
+		hostClientsMu.Unlock()
+	})
+
+	batch := NewPullRequestsBatch()
+	mine, err := batch.Add(SearchRequest{Query: "author:@me", Limit: 20, Host: host})
+	require.NoError(t, err)
+	hidden, err := batch.Add(SearchRequest{Query: "repo:hidden/repo", Limit: 20, Host: host})
+	require.NoError(t, err)
+
+	_, err = batch.Result(hidden)
+	require.ErrorContains(t, err, "Could not resolve to a Repository")
+
+	res, err := batch.Result(mine)
+	require.NoError(t, err)
+	require.Equal(t, "Mine", res.Prs[0].Title)
+
+	require.Len(t, stub.requests, 3, "the failed batch should be retried one search at a time")
+}
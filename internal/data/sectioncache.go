@@ -0,0 +1,196 @@
+package data
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"charm.land/log/v2"
+)
+
+const sectionCacheDir = "cache"
+
+// maxSectionCacheAge is how long a search stays cached after it was last
+// fetched. The searches of sections that were edited or removed would stay
+// on disk forever otherwise.
+const maxSectionCacheAge = 30 * 24 * time.Hour
+
+// pruneSectionCacheOnce prunes the cache on the first save of a run
+var pruneSectionCacheOnce sync.Once
+
+// sectionCacheEntry is the on-disk format of a cached section result.
+type sectionCacheEntry[T any] struct {
+	FetchedAt time.Time `json:"fetchedAt"`
+	Response  T         `json:"response"`
+}
+
+// LoadCachedPullRequests returns the last result fetched for the PRs search,
+// so sections can render it while they refetch. ok is false if nothing was
+// cached yet.
+func LoadCachedPullRequests(
+	query string,
+	limit int,
+	host string,
+) (res PullRequestsResponse, fetchedAt time.Time, ok bool) {
+	return loadSectionCache[PullRequestsResponse]("prs", query, limit, host)
+}
+
+// SaveCachedPullRequests stores the first page of the PRs search on disk.
+func SaveCachedPullRequests(query string, limit int, host string, res PullRequestsResponse) {
+	saveSectionCache("prs", query, limit, host, res)
+}
+
+// LoadCachedIssues returns the last result fetched for the issues search, so
+// sections can render it while they refetch. ok is false if nothing was cached
+// yet.
+func LoadCachedIssues(
+	query string,
+	limit int,
+	host string,
+) (res IssuesResponse, fetchedAt time.Time, ok bool) {
+	return loadSectionCache[IssuesResponse]("issues", query, limit, host)
+}
+
+// SaveCachedIssues stores the first page of the issues search on disk.
+func SaveCachedIssues(query string, limit int, host string, res IssuesResponse) {
+	saveSectionCache("issues", query, limit, host, res)
+}
+
+// LoadCachedDiscussions returns the last result fetched for the discussions
+// search, so sections can render it while they refetch. ok is false if
+// nothing was cached yet.
+func LoadCachedDiscussions(
+	query string,
+	limit int,
+	host string,
+) (res DiscussionsResponse, fetchedAt time.Time, ok bool) {
+	return loadSectionCache[DiscussionsResponse]("discussions", query, limit, host)
+}
+
+// SaveCachedDiscussions stores the first page of the discussions search on
+// disk.
+func SaveCachedDiscussions(query string, limit int, host string, res DiscussionsResponse) {
+	saveSectionCache("discussions", query, limit, host, res)
+}
+
+// getSectionCacheFilePath returns where the result of a search is cached.
+// The host is only part of the key when it's set, so the caches of the
+// default host stay where they were.
+func getSectionCacheFilePath(kind, query string, limit int, host string) (string, error) {
+	key := fmt.Appendf(nil, "%s\x00%d", query, limit)
+	if host != "" {
+		key = fmt.Appendf(nil, "%s\x00%s", host, key)
+	}
+	sum := sha256.Sum256(key)
+	filename := fmt.Sprintf("%s-%s.json", kind, hex.EncodeToString(sum[:8]))
+	return getStateFilePath(filepath.Join(sectionCacheDir, filename))
+}
+
+func loadSectionCache[T any](kind, query string, limit int, host string) (T, time.Time, bool) {
+	var entry sectionCacheEntry[T]
+	filePath, err := getSectionCacheFilePath(kind, query, limit, host)
+	if err != nil {
+		log.Error("Failed to get section cache path", "err", err)
+		return entry.Response, time.Time{}, false
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error("Failed to read section cache", "path", filePath, "err", err)
+		}
+		return entry.Response, time.Time{}, false
+	}
+
+	if err := json.Unmarshal(data, &entry); err != nil {
+		log.Error("Failed to parse section cache", "path", filePath, "err", err)
+		return entry.Response, time.Time{}, false
+	}
+
+	log.Debug("Loaded section cache", "kind", kind, "query", query, "fetchedAt", entry.FetchedAt)
+	return entry.Response, entry.FetchedAt, true
+}
+
+func saveSectionCache[T any](kind, query string, limit int, host string, res T) {
+	filePath, err := getSectionCacheFilePath(kind, query, limit, host)
+	if err != nil {
+		log.Error("Failed to get section cache path", "err", err)
+		return
+	}
+
+	pruneSectionCacheOnce.Do(func() {
+		pruneSectionCache(filepath.Dir(filePath), time.Now())
+	})
+
+	if err := writeSectionCache(filePath, sectionCacheEntry[T]{
+		FetchedAt: time.Now(),
+		Response:  res,
+	}); err != nil {
+		log.Error("Failed to save section cache", "path", filePath, "err", err)
+		return
+	}
+
+	log.Debug("Saved section cache", "kind", kind, "query", query)
+}
+
+// pruneSectionCache removes the cached searches that weren't saved for
+// maxSectionCacheAge, along with temp files left by interrupted writes
+func pruneSectionCache(dir string, now time.Time) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error("Failed to read section cache dir", "dir", dir, "err", err)
+		}
+		return
+	}
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || info.IsDir() || now.Sub(info.ModTime()) < maxSectionCacheAge {
+			continue
+		}
+		filePath := filepath.Join(dir, entry.Name())
+		if err := os.Remove(filePath); err != nil {
+			log.Error("Failed to remove stale section cache", "path", filePath, "err", err)
+		}
+	}
+}
+
+func writeSectionCache(filePath string, entry any) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	// Several sections can share a search, so write atomically to avoid
+	// readers seeing a partially written file.
+	tmpFile, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
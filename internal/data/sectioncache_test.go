@@ -0,0 +1,58 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSectionCacheRoundTrip(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	_, _, ok := LoadCachedPullRequests("is:open author:@me", 20, "")
+	require.False(t, ok)
+
+	SaveCachedPullRequests("is:open author:@me", 20, "", PullRequestsResponse{
+		Prs:        []PullRequestData{{Number: 1, Title: "Fix the thing"}},
+		TotalCount: 1,
+	})
+
+	res, fetchedAt, ok := LoadCachedPullRequests("is:open author:@me", 20, "")
+	require.True(t, ok)
+	require.False(t, fetchedAt.IsZero())
+	require.Equal(t, 1, res.TotalCount)
+	require.Equal(t, "Fix the thing", res.Prs[0].Title)
+
+	_, _, ok = LoadCachedPullRequests("is:open author:@me", 50, "")
+	require.False(t, ok, "a different limit should not hit the cache")
+	_, _, ok = LoadCachedIssues("is:open author:@me", 20, "")
+	require.False(t, ok, "issues and PRs should be cached separately")
+	_, _, ok = LoadCachedPullRequests("is:open author:@me", 20, "ghe.example.com")
+	require.False(t, ok, "each host should be cached separately")
+}
+
+func TestPruneSectionCache(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	write := func(name string, modTime time.Time) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte("{}"), 0o644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	write("prs-fresh.json", now.Add(-time.Hour))
+	write("prs-stale.json", now.Add(-maxSectionCacheAge-time.Hour))
+	write(".tmp-123", now.Add(-maxSectionCacheAge-time.Hour))
+
+	pruneSectionCache(dir, now)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "prs-fresh.json", entries[0].Name())
+
+	// A cache that was never written is fine
+	pruneSectionCache(filepath.Join(dir, "missing"), now)
+}
@@ -1,11 +1,7 @@
 package data
 
-import (
-	gh "github.com/cli/go-gh/v2/pkg/api"
-)
-
 func CurrentLoginName() (string, error) {
-	client, err := gh.DefaultGraphQLClient()
+	client, err := defaultGraphQLClient()
 	if err != nil {
 		return "", nil
 	}
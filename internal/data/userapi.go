@@ -4,13 +4,15 @@ import (
 	"sync"
 
 	"charm.land/log/v2"
-	gh "github.com/cli/go-gh/v2/pkg/api"
 	graphql "github.com/cli/shurcooL-graphql"
 )
 
 var (
 	repoUserCache = make(map[string][]User)
 	userCacheMu   sync.RWMutex
+
+	viewerTeamsCache = make(map[string][]string)
+	viewerTeamsMu    sync.Mutex
 )
 
 type User struct {
@@ -26,20 +28,29 @@ type MentionableUsersResponse struct {
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
-func CachedRepoUsers(repoNameWithOwner string) ([]User, bool) {
+func CachedRepoUsers(repoNameWithOwner string, host string) ([]User, bool) {
 	userCacheMu.RLock()
 	defer userCacheMu.RUnlock()
-	users, ok := repoUserCache[repoNameWithOwner]
+	users, ok := repoUserCache[repoCacheKey(repoNameWithOwner, host)]
 	return users, ok
 }
 
+// repoCacheKey keeps repos with the same name on different hosts apart. It's
+// also how gh's --repo flag takes a repo of another host.
+func repoCacheKey(repoNameWithOwner string, host string) string {
+	if key := hostKey(host); key != "" {
+		return key + "/" + repoNameWithOwner
+	}
+	return repoNameWithOwner
+}
+
 // FetchRepoUsers fetches users that can be mentioned in a repository.
 // It uses the publicly available mentionableUsers field which includes
 // anyone who can interact with the repository (issue/PR authors, commenters, etc.)
-func FetchRepoUsers(owner, repoName string) ([]User, error) {
+func FetchRepoUsers(owner, repoName string, host string) ([]User, error) {
 	// Check cache first
 	repo := owner + "/" + repoName
-	if cachedUsers, ok := CachedRepoUsers(repo); ok {
+	if cachedUsers, ok := CachedRepoUsers(repo, host); ok {
 		log.Debug(
 			"Using cached repo users",
 			"owner",
@@ -54,13 +65,9 @@ func FetchRepoUsers(owner, repoName string) ([]User, error) {
 
 	log.Debug("Fetching repo users", "owner", owner, "repoName", repoName)
 
-	// Initialize client if needed
-	if client == nil {
-		var err error
-		client, err = gh.DefaultGraphQLClient()
-		if err != nil {
-			return nil, err
-		}
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return nil, err
 	}
 
 	// Query only publicly available mentionable users
@@ -72,7 +79,7 @@ func FetchRepoUsers(owner, repoName string) ([]User, error) {
 		"limit": graphql.Int(100),
 	}
 
-	err := client.Query("GetMentionableUsers", &result, variables)
+	err = client.Query("GetMentionableUsers", &result, variables)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +94,7 @@ func FetchRepoUsers(owner, repoName string) ([]User, error) {
 	userCacheMu.Lock()
 	defer userCacheMu.Unlock()
 
-	repoUserCache[repo] = users
+	repoUserCache[repoCacheKey(repo, host)] = users
 	log.Debug(
 		"Successfully fetched repo users",
 		"owner",
@@ -106,10 +113,51 @@ func ClearUserCache() {
 	repoUserCache = make(map[string][]User)
 }
 
-func ClearRepoUserCache(repoNameWithOwner string) {
+func ClearRepoUserCache(repoNameWithOwner string, host string) {
 	userCacheMu.Lock()
 	defer userCacheMu.Unlock()
-	delete(repoUserCache, repoNameWithOwner)
+	delete(repoUserCache, repoCacheKey(repoNameWithOwner, host))
+}
+
+// FetchViewerTeams returns the slugs of the teams of org the viewer, whose
+// login is given, is a member of. They're cached for the session.
+func FetchViewerTeams(org string, login string, host string) ([]string, error) {
+	key := repoCacheKey(org, host)
+	viewerTeamsMu.Lock()
+	defer viewerTeamsMu.Unlock()
+	if teams, ok := viewerTeamsCache[key]; ok {
+		return teams, nil
+	}
+
+	client, err := graphQLClientForHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	var query struct {
+		Organization struct {
+			Teams struct {
+				Nodes []struct {
+					Slug string
+				}
+			} `graphql:"teams(first: 100, userLogins: [$login])"`
+		} `graphql:"organization(login: $org)"`
+	}
+	variables := map[string]any{
+		"org":   graphql.String(org),
+		"login": graphql.String(login),
+	}
+	log.Debug("Fetching viewer teams", "org", org)
+	if err := client.Query("ViewerTeams", &query, variables); err != nil {
+		return nil, err
+	}
+
+	teams := make([]string, 0, len(query.Organization.Teams.Nodes))
+	for _, team := range query.Organization.Teams.Nodes {
+		teams = append(teams, team.Slug)
+	}
+	viewerTeamsCache[key] = teams
+	return teams, nil
 }
 
 func UserLogins(users []User) []string {
@@ -0,0 +1,272 @@
+// Package mockserver is a fake GitHub API that replays recorded responses,
+// so gh-dash can run and be tested without network access or credentials.
+//
+// GraphQL requests are answered from graphql/<Operation>-<hash>.json, where
+// hash identifies the variables of the request, falling back to
+// graphql/<Operation>.json. REST requests are answered the same way from
+// rest/<METHOD>/<path>-<hash>.json, where hash identifies the query string,
+// falling back to rest/<METHOD>/<path>.json. REST requests that change state
+// are acknowledged with 204 No Content when they don't have a fixture.
+//
+// In record mode requests without a fixture are sent to GitHub and the
+// responses are saved as fixtures for the next runs.
+package mockserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"charm.land/log/v2"
+)
+
+const (
+	graphqlPath = "/api/graphql"
+	restPrefix  = "/api/v3/"
+
+	// DefaultUpstreamURL is where record mode sends requests to
+	DefaultUpstreamURL = "https://api.github.com/"
+)
+
+var operationRegex = regexp.MustCompile(`^\s*(?:query|mutation)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+
+type Options struct {
+	// FixturesDir is the directory fixtures are read from and recorded to
+	FixturesDir string
+	// Record sends requests without a fixture to UpstreamURL and saves the
+	// responses as fixtures
+	Record bool
+	// UpstreamURL is the GitHub API record mode sends requests to,
+	// DefaultUpstreamURL if empty
+	UpstreamURL string
+	// UpstreamClient sends the recorded requests, it's expected to add the
+	// credentials of the user
+	UpstreamClient *http.Client
+}
+
+type Server struct {
+	opts Options
+}
+
+func New(opts Options) (*Server, error) {
+	if opts.FixturesDir == "" {
+		return nil, errors.New("a fixtures directory is required")
+	}
+	if opts.Record && opts.UpstreamClient == nil {
+		return nil, errors.New("record mode requires an upstream client")
+	}
+	if opts.UpstreamURL == "" {
+		opts.UpstreamURL = DefaultUpstreamURL
+	}
+
+	return &Server{opts: opts}, nil
+}
+
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Debug("mock server got request", "method", r.Method, "url", r.URL)
+
+	switch {
+	case r.URL.Path == graphqlPath || r.URL.Path == "/graphql":
+		s.serveGraphQL(w, r)
+	case strings.HasPrefix(r.URL.Path, restPrefix):
+		s.serveREST(w, r, strings.TrimPrefix(r.URL.Path, restPrefix))
+	default:
+		s.serveREST(w, r, strings.TrimPrefix(r.URL.Path, "/"))
+	}
+}
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var req graphqlRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		return
+	}
+
+	operation := OperationName(req.Query)
+	if operation == "" {
+		writeGraphQLError(w, http.StatusBadRequest, "only named operations can be replayed")
+		return
+	}
+
+	key := hashKey(req.Variables)
+	base := filepath.Join(s.opts.FixturesDir, "graphql", operation)
+	if s.serveFixture(w, base, key) {
+		return
+	}
+
+	if s.opts.Record {
+		s.record(w, r, s.opts.UpstreamURL+"graphql", body, base+"-"+key+".json")
+		return
+	}
+
+	log.Warn("mock server has no fixture", "operation", operation, "variables", req.Variables)
+	writeGraphQLError(w, http.StatusNotFound, fmt.Sprintf(
+		"no fixture for operation %s, add %s.json or record it", operation, base))
+}
+
+func (s *Server) serveREST(w http.ResponseWriter, r *http.Request, apiPath string) {
+	apiPath = path.Clean("/" + apiPath)[1:]
+	if apiPath == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	key := hashKey(r.URL.Query())
+	base := filepath.Join(s.opts.FixturesDir, "rest", r.Method, filepath.FromSlash(apiPath))
+	if s.serveFixture(w, base, key) {
+		return
+	}
+
+	if s.opts.Record {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		upstreamUrl := s.opts.UpstreamURL + apiPath
+		if r.URL.RawQuery != "" {
+			upstreamUrl += "?" + r.URL.RawQuery
+		}
+		s.record(w, r, upstreamUrl, body, base+"-"+key+".json")
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	log.Warn("mock server has no fixture", "method", r.Method, "path", apiPath)
+	http.Error(w, fmt.Sprintf("no fixture for %s %s", r.Method, apiPath), http.StatusNotFound)
+}
+
+// serveFixture writes the fixture recorded for the key if there's one, or
+// else the one shared by all keys
+func (s *Server) serveFixture(w http.ResponseWriter, base, key string) bool {
+	for _, candidate := range []string{base + "-" + key + ".json", base + ".json"} {
+		data, err := os.ReadFile(candidate)
+		if err != nil {
+			continue
+		}
+
+		log.Debug("mock server replaying fixture", "path", candidate)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+		return true
+	}
+	return false
+}
+
+// record sends the request to GitHub, saves successful responses as a
+// fixture and writes the response back
+func (s *Server) record(
+	w http.ResponseWriter,
+	r *http.Request,
+	upstreamUrl string,
+	body []byte,
+	fixturePath string,
+) {
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, upstreamUrl, bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", r.Header.Get("Accept"))
+
+	res, err := s.opts.UpstreamClient.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 && len(data) > 0 {
+		if err := writeFixture(fixturePath, data); err != nil {
+			log.Error("mock server failed saving fixture", "path", fixturePath, "err", err)
+		} else {
+			log.Info("mock server recorded fixture", "path", fixturePath)
+		}
+	}
+
+	w.Header().Set("Content-Type", res.Header.Get("Content-Type"))
+	if link := res.Header.Get("Link"); link != "" {
+		w.Header().Set("Link", link)
+	}
+	w.WriteHeader(res.StatusCode)
+	_, _ = w.Write(data)
+}
+
+func writeFixture(fixturePath string, data []byte) error {
+	// Indent the recorded responses so fixtures can be read and edited
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err == nil {
+		data = append(indented.Bytes(), '\n')
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fixturePath), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(fixturePath, data, 0o644)
+}
+
+// OperationName returns the name of the GraphQL operation of the query, or
+// an empty string for anonymous operations
+func OperationName(query string) string {
+	matches := operationRegex.FindStringSubmatch(query)
+	if matches == nil {
+		return ""
+	}
+	return matches[1]
+}
+
+// hashKey identifies the variables of a GraphQL request or the query string
+// of a REST request. Maps are encoded with sorted keys so the key is stable.
+func hashKey(v any) string {
+	if values, ok := v.(url.Values); ok {
+		v = map[string][]string(values)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		data = []byte(fmt.Sprint(v))
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:4])
+}
+
+func writeGraphQLError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
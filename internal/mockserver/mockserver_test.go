@@ -0,0 +1,184 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func writeTestFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+	require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+}
+
+func newTestServer(t *testing.T, opts Options) *httptest.Server {
+	t.Helper()
+	server, err := New(opts)
+	require.NoError(t, err)
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func postGraphQL(t *testing.T, url, query string, variables map[string]any) (int, string) {
+	t.Helper()
+	body, err := json.Marshal(graphqlRequest{Query: query, Variables: variables})
+	require.NoError(t, err)
+	res, err := http.Post(url+graphqlPath, "application/json", strings.NewReader(string(body)))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res.StatusCode, string(data)
+}
+
+func TestOperationName(t *testing.T) {
+	require.Equal(t, "SearchPullRequests",
+		OperationName("query SearchPullRequests($query:String!){search(query: $query){issueCount}}"))
+	require.Equal(t, "AddDiscussionComment",
+		OperationName("\n  mutation AddDiscussionComment($input:AddDiscussionCommentInput!){x}"))
+	require.Equal(t, "", OperationName("{viewer{login}}"))
+}
+
+func TestServeGraphQL_ReplaysOperationFixture(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFixture(t, dir, "graphql/UserCurrent.json", `{"data":{"viewer":{"login":"dlvhdr"}}}`)
+	ts := newTestServer(t, Options{FixturesDir: dir})
+
+	status, body := postGraphQL(t, ts.URL, "query UserCurrent{viewer{login}}", nil)
+
+	require.Equal(t, http.StatusOK, status)
+	require.JSONEq(t, `{"data":{"viewer":{"login":"dlvhdr"}}}`, body)
+}
+
+func TestServeGraphQL_PrefersFixtureForVariables(t *testing.T) {
+	dir := t.TempDir()
+	variables := map[string]any{"query": "is:open author:@me"}
+	writeTestFixture(t, dir, "graphql/SearchIssues.json", `{"data":{"search":{"issueCount":1}}}`)
+	writeTestFixture(t, dir, "graphql/SearchIssues-"+hashKey(variables)+".json",
+		`{"data":{"search":{"issueCount":2}}}`)
+	ts := newTestServer(t, Options{FixturesDir: dir})
+
+	_, body := postGraphQL(t, ts.URL, "query SearchIssues($query:String!){x}", variables)
+	require.JSONEq(t, `{"data":{"search":{"issueCount":2}}}`, body)
+
+	_, body = postGraphQL(t, ts.URL, "query SearchIssues($query:String!){x}",
+		map[string]any{"query": "is:closed"})
+	require.JSONEq(t, `{"data":{"search":{"issueCount":1}}}`, body)
+}
+
+func TestServeGraphQL_MissingFixture(t *testing.T) {
+	ts := newTestServer(t, Options{FixturesDir: t.TempDir()})
+
+	status, body := postGraphQL(t, ts.URL, "query FetchIssue{x}", nil)
+
+	require.Equal(t, http.StatusNotFound, status)
+	require.Contains(t, body, "no fixture for operation FetchIssue")
+}
+
+func TestServeREST(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFixture(t, dir, "rest/GET/notifications.json", `[{"id":"1"}]`)
+	ts := newTestServer(t, Options{FixturesDir: dir})
+
+	res, err := http.Get(ts.URL + restPrefix + "notifications?all=true&page=1")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.JSONEq(t, `[{"id":"1"}]`, string(body))
+
+	req, err := http.NewRequest(http.MethodPatch, ts.URL+restPrefix+"notifications/threads/1", nil)
+	require.NoError(t, err)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res, err = http.Get(ts.URL + restPrefix + "notifications/threads/1")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestRecord(t *testing.T) {
+	upstreamCalls := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamCalls++
+		require.Equal(t, "/graphql", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"recorded"}}}`))
+	}))
+	defer upstream.Close()
+
+	dir := t.TempDir()
+	ts := newTestServer(t, Options{
+		FixturesDir:    dir,
+		Record:         true,
+		UpstreamURL:    upstream.URL + "/",
+		UpstreamClient: upstream.Client(),
+	})
+
+	for range 2 {
+		status, body := postGraphQL(t, ts.URL, "query UserCurrent{viewer{login}}", nil)
+		require.Equal(t, http.StatusOK, status)
+		require.JSONEq(t, `{"data":{"viewer":{"login":"recorded"}}}`, body)
+	}
+
+	require.Equal(t, 1, upstreamCalls, "the second request should be replayed")
+	recorded, err := filepath.Glob(filepath.Join(dir, "graphql", "UserCurrent-*.json"))
+	require.NoError(t, err)
+	require.Len(t, recorded, 1)
+}
+
+func TestNew_RecordRequiresUpstreamClient(t *testing.T) {
+	_, err := New(Options{FixturesDir: t.TempDir(), Record: true})
+	require.Error(t, err)
+}
+
+func TestRepoFixtures(t *testing.T) {
+	ts := newTestServer(t, Options{FixturesDir: filepath.Join("..", "..", "testdata", "mock")})
+
+	client, err := gh.NewGraphQLClient(gh.ClientOptions{
+		Host:               strings.TrimPrefix(ts.URL, "http://"),
+		AuthToken:          "fake-token",
+		Transport:          &rewriteTransport{url: ts.URL},
+		SkipDefaultHeaders: true,
+	})
+	require.NoError(t, err)
+	data.SetClient(client)
+	t.Cleanup(func() { data.SetClient(nil) })
+
+	prs, err := data.FetchPullRequests("author:@me", 20, nil, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, prs.Prs)
+
+	issues, err := data.FetchIssues("author:@me", 20, nil, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, issues.Issues)
+}
+
+// rewriteTransport sends the requests of the client to the test server over
+// plain HTTP, as the client only uses HTTPS for hosts other than github.com
+type rewriteTransport struct {
+	url string
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = "http"
+	req.URL.Host = strings.TrimPrefix(t.url, "http://")
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 )
 
 // Command resolves a *exec.Cmd that runs `cmd` through a sensible shell:
@@ -30,3 +31,9 @@ func Command(cmd string) *exec.Cmd {
 	}
 	return exec.Command("sh", "-c", cmd)
 }
+
+// Quote quotes s for a POSIX shell, so it's passed to the command as a
+// single argument whatever it contains, e.g. "$(...)" or ";"
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -67,3 +67,27 @@ func TestCommand_PassesCommandStringIntact(t *testing.T) {
 		t.Fatalf("command string altered, got %q", c.Args[2])
 	}
 }
+
+func TestQuote_KeepsValuesOneArgument(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("quoting is for POSIX shells")
+	}
+	t.Setenv("SHELL", "sh")
+
+	for _, value := range []string{
+		"release-1.x",
+		"has spaces",
+		"$(echo injected)",
+		"a; echo injected",
+		"it's `quoted`",
+		"",
+	} {
+		out, err := Command("printf '%s' " + Quote(value)).Output()
+		if err != nil {
+			t.Fatalf("running the quoted %q failed: %v", value, err)
+		}
+		if string(out) != value {
+			t.Fatalf("expected %q to be passed as is, got %q", value, out)
+		}
+	}
+}
@@ -28,6 +28,13 @@ const (
 	ModeUnassign
 	ModeLabel
 	ModeSearch
+	ModeReviewComment
+	ModeReview
+	ModeThreadReply
+	ModeMergeMessage
+	ModeCommandPalette
+	ModeCommandPrompt
+	ModeCommandPromptPick
 )
 
 type FetchPolicy int
@@ -42,6 +49,9 @@ type RepoRef struct {
 	NameWithOwner string
 	Owner         string
 	Name          string
+	// Host is the GitHub Enterprise Server host of the repo, empty for the
+	// default host
+	Host string
 }
 
 type EnterOptions struct {
@@ -135,6 +145,19 @@ func (c *Controller) ViewCompletions() string {
 	return c.inputBox.ViewCompletions()
 }
 
+// Selected returns the highlighted suggestion, if the suggestions are shown
+func (c *Controller) Selected() string {
+	return c.fzfSelect.Selected()
+}
+
+func (c *Controller) SetInputPrompt(prompt string) {
+	c.inputBox.SetInputPrompt(prompt)
+}
+
+func (c *Controller) SetMaxSuggestions(n int) {
+	c.fzfSelect.SetMaxVisible(n)
+}
+
 func (c *Controller) Width() int {
 	return c.fzfSelect.Width()
 }
@@ -303,16 +326,16 @@ func (c *Controller) clearRelevantCache() {
 	switch c.fzfSelect.Source.(type) {
 	case *fuzzyselect.UserMentionSource:
 		if c.repo.NameWithOwner != "" {
-			data.ClearRepoUserCache(c.repo.NameWithOwner)
+			data.ClearRepoUserCache(c.repo.NameWithOwner, c.repo.Host)
 		}
 	case *fuzzyselect.LabelSource:
 		if c.repo.NameWithOwner != "" {
-			data.ClearRepoLabelCache(c.repo.NameWithOwner)
+			data.ClearRepoLabelCache(c.repo.NameWithOwner, c.repo.Host)
 		}
 	case *fuzzyselect.SearchQuerySource:
 		if c.repo.NameWithOwner != "" {
-			data.ClearRepoLabelCache(c.repo.NameWithOwner)
-			data.ClearRepoUserCache(c.repo.NameWithOwner)
+			data.ClearRepoLabelCache(c.repo.NameWithOwner, c.repo.Host)
+			data.ClearRepoUserCache(c.repo.NameWithOwner, c.repo.Host)
 		}
 	}
 }
@@ -368,7 +391,8 @@ func (c *Controller) resetAutocompleteState() {
 
 func (c Controller) usesAutocomplete() bool {
 	switch c.mode {
-	case ModeComment, ModeApprove, ModeAssign, ModeLabel, ModeSearch:
+	case ModeComment, ModeApprove, ModeAssign, ModeLabel, ModeSearch, ModeReviewComment,
+		ModeReview, ModeThreadReply, ModeCommandPalette, ModeCommandPromptPick:
 		return true
 	default:
 		return false
@@ -388,7 +412,11 @@ func (c Controller) loadSuggestions(showLoading bool) tea.Cmd {
 
 	fetchCmd := func() tea.Msg {
 		err := c.fzfSelect.Source.LoadSuggestions(
-			fuzzyselect.LoaderContext{RepoOwner: c.repo.Owner, RepoName: c.repo.Name},
+			fuzzyselect.LoaderContext{
+				RepoOwner: c.repo.Owner,
+				RepoName:  c.repo.Name,
+				Host:      c.repo.Host,
+			},
 		)
 		if err != nil {
 			return SourceFetchFailedMsg{Err: err}
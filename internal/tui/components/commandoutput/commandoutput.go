@@ -0,0 +1,206 @@
+// Package commandoutput runs a custom command in the background and shows
+// its output in the preview pane as it's written.
+package commandoutput
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	log "charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/shell"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// maxLines is how many lines of output are kept, older lines are dropped
+const maxLines = 5000
+
+// maxLineLength is the longest line read from the output, longer lines end
+// the stream
+const maxLineLength = 1024 * 1024
+
+type Model struct {
+	ctx     *context.ProgramContext
+	id      int
+	name    string
+	command string
+	lines   []string
+	process *os.Process
+	running bool
+	// stopped is true when the command was killed before it exited
+	stopped bool
+	err     error
+	isShown bool
+	width   int
+}
+
+// OutputMsg is a line written by the running command
+type OutputMsg struct {
+	id   int
+	line string
+	msgs <-chan tea.Msg
+}
+
+// FinishedMsg is sent when the command exits
+type FinishedMsg struct {
+	id  int
+	Err error
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{ctx: ctx}
+}
+
+// Run starts the command and shows the pane, stopping any command that is
+// still running
+func (m *Model) Run(name, command string) tea.Cmd {
+	m.Stop()
+	m.id++
+	m.name = name
+	m.command = command
+	m.lines = nil
+	m.err = nil
+	m.stopped = false
+	m.isShown = true
+
+	c := shell.Command(command)
+	startInOwnGroup(c)
+	stdout, err := c.StdoutPipe()
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	c.Stderr = c.Stdout
+	if err := c.Start(); err != nil {
+		m.err = err
+		return nil
+	}
+	m.process = c.Process
+	m.running = true
+	log.Debug("running custom command in the background", "cmd", command)
+
+	id := m.id
+	msgs := make(chan tea.Msg)
+	go func() {
+		defer close(msgs)
+		scanner := bufio.NewScanner(stdout)
+		scanner.Buffer(nil, maxLineLength)
+		for scanner.Scan() {
+			msgs <- OutputMsg{id: id, line: scanner.Text(), msgs: msgs}
+		}
+		// Wait must only be called once the output is fully read
+		_, _ = io.Copy(io.Discard, stdout)
+		msgs <- FinishedMsg{id: id, Err: c.Wait()}
+	}()
+
+	return waitForMsg(msgs)
+}
+
+func waitForMsg(msgs <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-msgs
+	}
+}
+
+// Stop kills the command if it's still running, along with the processes it
+// started as the shell may not pass the signal on to them
+func (m *Model) Stop() {
+	if !m.running || m.process == nil {
+		return
+	}
+	if err := killGroup(m.process); err != nil {
+		log.Error("failed killing custom command", "err", err)
+	}
+	m.running = false
+	m.stopped = true
+	m.process = nil
+}
+
+// Close stops the command and hides the pane
+func (m *Model) Close() {
+	m.Stop()
+	m.isShown = false
+}
+
+func (m *Model) IsShown() bool {
+	return m.isShown
+}
+
+func (m *Model) IsRunning() bool {
+	return m.running
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+}
+
+// Output is the output of the command so far
+func (m *Model) Output() string {
+	return strings.Join(m.lines, "\n")
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case OutputMsg:
+		// Keep draining the output of a stopped command so it can exit
+		if msg.id == m.id {
+			m.lines = append(m.lines, msg.line)
+			if len(m.lines) > maxLines {
+				m.lines = m.lines[len(m.lines)-maxLines:]
+			}
+		}
+		return m, waitForMsg(msg.msgs)
+
+	case FinishedMsg:
+		if msg.id != m.id || !m.running {
+			return m, nil
+		}
+		m.running = false
+		m.process = nil
+		m.err = msg.Err
+	}
+
+	return m, nil
+}
+
+func (m Model) View() string {
+	styles := m.ctx.Styles.Common
+
+	var status string
+	switch {
+	case m.running:
+		status = styles.WaitingGlyph + " " + styles.FaintTextStyle.Render("running")
+	case m.stopped:
+		status = styles.ActionRequiredGlyph + " " + styles.FaintTextStyle.Render("stopped")
+	case m.err != nil:
+		status = styles.FailureGlyph + " " + styles.ErrorStyle.Render(m.err.Error())
+	default:
+		status = styles.SuccessGlyph + " " + styles.FaintTextStyle.Render("done")
+	}
+
+	width := max(0, m.width-2)
+	output := m.Output()
+	if output == "" && !m.running {
+		output = styles.FaintTextStyle.Render("No output")
+	}
+
+	return lipgloss.NewStyle().PaddingLeft(1).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.MainTextStyle.Render(m.name),
+		lipgloss.Wrap(styles.FaintTextStyle.Render(fmt.Sprintf("$ %s", m.command)), width, ""),
+		status,
+		"",
+		lipgloss.Wrap(output, width, ""),
+		"",
+		styles.FaintTextStyle.Render("Press esc to close"),
+	))
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
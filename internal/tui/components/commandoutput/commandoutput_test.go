@@ -0,0 +1,92 @@
+package commandoutput
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func newTestModel() Model {
+	thm := *theme.DefaultTheme
+	return NewModel(&context.ProgramContext{
+		Theme:  thm,
+		Styles: context.InitStyles(thm),
+	})
+}
+
+// runToCompletion feeds the messages of the command back to the model until
+// the command exits
+func runToCompletion(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	for cmd != nil {
+		msg := cmd()
+		m, cmd = m.Update(msg)
+		if _, ok := msg.(FinishedMsg); ok {
+			require.Nil(t, cmd)
+		}
+	}
+	return m
+}
+
+func TestRunCapturesOutput(t *testing.T) {
+	t.Setenv("SHELL", "sh")
+	m := newTestModel()
+
+	cmd := m.Run("Deploy status", "echo first; echo second >&2")
+	require.True(t, m.IsShown())
+	require.True(t, m.IsRunning())
+
+	m = runToCompletion(t, m, cmd)
+
+	require.False(t, m.IsRunning())
+	require.NoError(t, m.err)
+	require.Equal(t, "first\nsecond", m.Output())
+	require.Contains(t, m.View(), "Deploy status")
+}
+
+func TestRunReportsExitStatus(t *testing.T) {
+	t.Setenv("SHELL", "sh")
+	m := newTestModel()
+
+	m = runToCompletion(t, m, m.Run("Fail", "echo oops; exit 3"))
+
+	require.Error(t, m.err)
+	require.Equal(t, "oops", m.Output())
+	require.Contains(t, m.View(), "exit status 3")
+}
+
+func TestRunIgnoresStoppedCommand(t *testing.T) {
+	t.Setenv("SHELL", "sh")
+	m := newTestModel()
+
+	stale := m.Run("Slow", "echo stale; sleep 10")
+	staleMsg := stale()
+	m.Close()
+	require.False(t, m.IsShown())
+	require.False(t, m.IsRunning())
+
+	cmd := m.Run("Fast", "echo fresh")
+	m, _ = m.Update(staleMsg)
+	m = runToCompletion(t, m, cmd)
+
+	require.Equal(t, "fresh", m.Output())
+}
+
+func TestStopShowsStoppedStatus(t *testing.T) {
+	t.Setenv("SHELL", "sh")
+	m := newTestModel()
+
+	m.Run("Slow", "sleep 10")
+	m.Stop()
+
+	require.False(t, m.IsRunning())
+	require.Contains(t, m.View(), "stopped")
+	require.NotContains(t, m.View(), "done")
+
+	m = runToCompletion(t, m, m.Run("Fast", "echo fresh"))
+	require.Contains(t, m.View(), "done")
+}
@@ -0,0 +1,15 @@
+//go:build !unix
+
+package commandoutput
+
+import (
+	"os"
+	"os/exec"
+)
+
+func startInOwnGroup(c *exec.Cmd) {}
+
+// killGroup kills p, process groups are only supported on unix
+func killGroup(p *os.Process) error {
+	return p.Kill()
+}
@@ -0,0 +1,20 @@
+//go:build unix
+
+package commandoutput
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// startInOwnGroup makes the command the leader of a new process group, so
+// the processes the shell starts can be killed along with it
+func startInOwnGroup(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killGroup kills the process group led by p
+func killGroup(p *os.Process) error {
+	return syscall.Kill(-p.Pid, syscall.SIGKILL)
+}
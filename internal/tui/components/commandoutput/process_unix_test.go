@@ -0,0 +1,40 @@
+//go:build unix
+
+package commandoutput
+
+import (
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopKillsChildProcesses(t *testing.T) {
+	t.Setenv("SHELL", "sh")
+	m := newTestModel()
+
+	// The background sleep keeps the output open until it's killed too
+	cmd := m.Run("Slow", "sleep 30 & echo started; wait")
+	msg := cmd()
+	require.Equal(t, "started", msg.(OutputMsg).line)
+	m.Stop()
+
+	finished := make(chan tea.Msg)
+	go func() {
+		cmd := waitForMsg(msg.(OutputMsg).msgs)
+		for {
+			msg := cmd()
+			if _, ok := msg.(OutputMsg); !ok {
+				finished <- msg
+				return
+			}
+		}
+	}()
+	select {
+	case msg := <-finished:
+		require.IsType(t, FinishedMsg{}, msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the output wasn't closed, the sleep is still running")
+	}
+}
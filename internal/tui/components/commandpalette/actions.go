@@ -0,0 +1,146 @@
+package commandpalette
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+)
+
+// Action is a keybinding the palette can run
+type Action struct {
+	// Key is the key that runs the action, e.g. "ctrl+d"
+	Key string
+	// HelpKey is how the keybinding is shown in the help, e.g. "↓/j"
+	HelpKey string
+	Desc    string
+}
+
+// ActionsFrom lists the enabled bindings as actions, in their order. Bindings
+// with the same description are listed once.
+func ActionsFrom(bindings []key.Binding) []Action {
+	actions := make([]Action, 0, len(bindings))
+	seen := make(map[string]bool)
+	for _, binding := range bindings {
+		help := binding.Help()
+		if !binding.Enabled() || len(binding.Keys()) == 0 || help.Desc == "" || seen[help.Desc] {
+			continue
+		}
+		seen[help.Desc] = true
+		actions = append(actions, Action{
+			Key:     binding.Keys()[0],
+			HelpKey: help.Key,
+			Desc:    help.Desc,
+		})
+	}
+	return actions
+}
+
+// actionSource suggests the actions by their description, the whole input
+// being the text to match
+type actionSource struct {
+	actions []Action
+}
+
+func (src *actionSource) ExtractContext(input string, cursorPos tea.Position) fuzzyselect.Context {
+	return fuzzyselect.Context{
+		End:     tea.Position{X: len([]rune(input))},
+		Content: strings.TrimSpace(input),
+	}
+}
+
+func (src *actionSource) InsertSuggestion(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+	contextEnd tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	return suggestion, tea.Position{X: len([]rune(suggestion))}
+}
+
+func (*actionSource) ItemsToExclude(input string, cursorPos tea.Position) []string {
+	return nil
+}
+
+func (src *actionSource) Suggestions(input string, cursorPos tea.Position) []fuzzyselect.Suggestion {
+	suggestions := make([]fuzzyselect.Suggestion, 0, len(src.actions))
+	for _, action := range src.actions {
+		suggestions = append(suggestions, fuzzyselect.Suggestion{
+			Value:  action.Desc,
+			Detail: action.HelpKey,
+		})
+	}
+	return suggestions
+}
+
+func (*actionSource) LoadSuggestions(ctx fuzzyselect.LoaderContext) error {
+	return nil
+}
+
+func (src *actionSource) action(desc string) (Action, bool) {
+	for _, action := range src.actions {
+		if action.Desc == desc {
+			return action, true
+		}
+	}
+	return Action{}, false
+}
+
+var namedKeys = map[string]rune{
+	"enter":     tea.KeyEnter,
+	"tab":       tea.KeyTab,
+	"esc":       tea.KeyEscape,
+	"space":     tea.KeySpace,
+	"backspace": tea.KeyBackspace,
+	"delete":    tea.KeyDelete,
+	"insert":    tea.KeyInsert,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"home":      tea.KeyHome,
+	"end":       tea.KeyEnd,
+	"pgup":      tea.KeyPgUp,
+	"pgdown":    tea.KeyPgDown,
+}
+
+// KeyPress returns the key press of a key as it's written in keybindings,
+// e.g. "ctrl+d", "O" or "enter"
+func KeyPress(keystroke string) tea.KeyPressMsg {
+	var mod tea.KeyMod
+	name := keystroke
+loop:
+	for {
+		prefix, rest, found := strings.Cut(name, "+")
+		if !found || rest == "" {
+			break
+		}
+		switch prefix {
+		case "ctrl":
+			mod |= tea.ModCtrl
+		case "alt":
+			mod |= tea.ModAlt
+		case "shift":
+			mod |= tea.ModShift
+		default:
+			break loop
+		}
+		name = rest
+	}
+
+	if code, ok := namedKeys[name]; ok {
+		return tea.KeyPressMsg{Code: code, Mod: mod}
+	}
+
+	runes := []rune(name)
+	if len(runes) != 1 {
+		return tea.KeyPressMsg{Code: tea.KeyExtended, Text: name, Mod: mod}
+	}
+	msg := tea.KeyPressMsg{Code: runes[0], Mod: mod}
+	if mod == 0 {
+		msg.Text = name
+	}
+	return msg
+}
@@ -0,0 +1,138 @@
+// Package commandpalette lists the actions available in the current view,
+// built-in and custom, and runs the chosen one
+package commandpalette
+
+import (
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+const (
+	maxWidth       = 80
+	maxSuggestions = 12
+)
+
+// RunActionMsg is sent when an action is chosen in the palette
+type RunActionMsg struct {
+	Action Action
+}
+
+type Model struct {
+	ctx    *context.ProgramContext
+	cmpctl *cmpcontroller.Controller
+	source *actionSource
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ti := textinput.New()
+	ti.Prompt = " : "
+	ti.Placeholder = "Run an action..."
+	base := lipgloss.NewStyle()
+	ti.SetStyles(textinput.Styles{
+		Focused: textinput.StyleState{
+			Placeholder: base.Foreground(ctx.Theme.FaintText),
+			Prompt:      base.Foreground(ctx.Theme.SecondaryText),
+			Text:        base.Foreground(ctx.Theme.PrimaryText),
+		},
+		Cursor: textinput.CursorStyle{
+			Color: ctx.Theme.FaintText,
+			Shape: tea.CursorBar,
+			Blink: true,
+		},
+	})
+
+	ctl := cmpcontroller.New(ctx, inputbox.ModelOpts{TextInput: &ti})
+	ctl.SetMaxSuggestions(maxSuggestions)
+	m := Model{
+		ctx:    ctx,
+		cmpctl: &ctl,
+		source: &actionSource{},
+	}
+	m.syncStyles()
+	m.cmpctl.SetAutocompleteSource(m.source)
+
+	return m
+}
+
+// Open shows the palette with the actions of the bindings
+func (m *Model) Open(bindings []key.Binding) tea.Cmd {
+	m.source.actions = ActionsFrom(bindings)
+	m.cmpctl.SetWidth(m.width())
+	cmd := m.cmpctl.Enter(cmpcontroller.EnterOptions{
+		Mode:       cmpcontroller.ModeCommandPalette,
+		EnterFetch: cmpcontroller.FetchNone,
+	})
+	m.cmpctl.Filter()
+	m.cmpctl.ShowCompletions()
+	return cmd
+}
+
+func (m *Model) Close() {
+	m.cmpctl.Exit()
+}
+
+func (m *Model) IsOpen() bool {
+	return m.cmpctl != nil && m.cmpctl.Active()
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.IsOpen() {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "enter" {
+		action, ok := m.source.action(m.cmpctl.Selected())
+		if !ok {
+			return m, nil
+		}
+		m.Close()
+		return m, func() tea.Msg {
+			return RunActionMsg{Action: action}
+		}
+	}
+
+	cmd, _ := m.cmpctl.Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if !m.IsOpen() {
+		return ""
+	}
+
+	b := lipgloss.RoundedBorder()
+	b.BottomLeft = lipgloss.RoundedBorder().MiddleLeft
+	b.BottomRight = lipgloss.RoundedBorder().MiddleRight
+	input := m.ctx.Styles.Search.Root.
+		Border(b, true).
+		BorderForeground(m.ctx.Styles.Colors.OpenIssue).
+		Render(m.cmpctl.View())
+
+	return lipgloss.JoinVertical(lipgloss.Left, input, m.cmpctl.ViewCompletions())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	if m.cmpctl == nil {
+		return
+	}
+	m.ctx = ctx
+	m.cmpctl.UpdateProgramContext(ctx)
+	m.cmpctl.SetWidth(m.width())
+	m.syncStyles()
+}
+
+func (m *Model) width() int {
+	return max(20, min(maxWidth, m.ctx.ScreenWidth-4))
+}
+
+func (m *Model) syncStyles() {
+	selectStyles := m.ctx.Styles.Select
+	selectStyles.PopupStyle = m.ctx.Styles.Select.PopupStyle.BorderTop(false)
+	m.cmpctl.SetSelectStyles(selectStyles)
+}
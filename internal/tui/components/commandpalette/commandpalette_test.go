@@ -0,0 +1,109 @@
+package commandpalette
+
+import (
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func newTestModel(t *testing.T) Model {
+	t.Helper()
+
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	thm := theme.ParseTheme(&cfg)
+	return NewModel(&context.ProgramContext{
+		Config:      &cfg,
+		Theme:       thm,
+		Styles:      context.InitStyles(thm),
+		ScreenWidth: 120,
+	})
+}
+
+var testBindings = []key.Binding{
+	key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+	key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "move down")),
+	key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("Ctrl+d", "preview page down")),
+	key.NewBinding(key.WithKeys("O"), key.WithHelp("O", "cycle sort")),
+	key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "close"), key.WithDisabled()),
+	key.NewBinding(key.WithKeys("R"), key.WithHelp("R", "refresh")),
+}
+
+func typeText(m Model, text string) Model {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	return m
+}
+
+func TestActionsFrom(t *testing.T) {
+	require.Equal(t, []Action{
+		{Key: "r", HelpKey: "r", Desc: "refresh"},
+		{Key: "down", HelpKey: "↓/j", Desc: "move down"},
+		{Key: "ctrl+d", HelpKey: "Ctrl+d", Desc: "preview page down"},
+		{Key: "O", HelpKey: "O", Desc: "cycle sort"},
+	}, ActionsFrom(testBindings))
+}
+
+func TestKeyPress(t *testing.T) {
+	for _, binding := range []key.Binding{
+		key.NewBinding(key.WithKeys("r")),
+		key.NewBinding(key.WithKeys("O")),
+		key.NewBinding(key.WithKeys("ctrl+d")),
+		key.NewBinding(key.WithKeys("alt+d")),
+		key.NewBinding(key.WithKeys("enter")),
+		key.NewBinding(key.WithKeys("space")),
+		key.NewBinding(key.WithKeys("down")),
+		key.NewBinding(key.WithKeys("*")),
+		key.NewBinding(key.WithKeys("[")),
+	} {
+		keystroke := binding.Keys()[0]
+		require.True(t, key.Matches(KeyPress(keystroke), binding), keystroke)
+	}
+}
+
+func TestRunSelectedAction(t *testing.T) {
+	m := newTestModel(t)
+	m.Open(testBindings)
+	require.True(t, m.IsOpen())
+	require.Contains(t, m.View(), "preview page down")
+
+	m = typeText(m, "sort")
+	m, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	require.False(t, m.IsOpen())
+	require.NotNil(t, cmd)
+	require.Equal(t, RunActionMsg{
+		Action: Action{Key: "O", HelpKey: "O", Desc: "cycle sort"},
+	}, cmd())
+}
+
+func TestEscClosesPalette(t *testing.T) {
+	m := newTestModel(t)
+	m.Open(testBindings)
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	require.False(t, m.IsOpen())
+}
+
+func TestNoMatchKeepsPaletteOpen(t *testing.T) {
+	m := newTestModel(t)
+	m.Open(testBindings)
+
+	m = typeText(m, "zzz")
+	m, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	require.True(t, m.IsOpen())
+	require.Nil(t, cmd)
+}
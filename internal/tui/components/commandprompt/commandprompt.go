@@ -0,0 +1,308 @@
+// Package commandprompt asks the prompts of a custom keybinding, and its
+// confirmation, before the keybinding's command runs
+package commandprompt
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"strings"
+	"text/template"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/shell"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prompt"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+const (
+	maxWidth       = 80
+	maxSuggestions = 10
+)
+
+// SubmitMsg is sent once every prompt is answered and the command is
+// confirmed. Input holds the template input with the answers, quoted for the
+// shell as labels and users can be named anything by others.
+type SubmitMsg struct {
+	Keybinding config.Keybinding
+	Input      map[string]any
+}
+
+type Model struct {
+	ctx        *context.ProgramContext
+	cmpctl     *cmpcontroller.Controller
+	source     *pickSource
+	confirmBox prompt.Model
+	keybinding config.Keybinding
+	input      map[string]any
+	step       int
+	isOpen     bool
+	confirming bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ti := textinput.New()
+	base := lipgloss.NewStyle()
+	ti.SetStyles(textinput.Styles{
+		Focused: textinput.StyleState{
+			Placeholder: base.Foreground(ctx.Theme.FaintText),
+			Prompt:      base.Foreground(ctx.Theme.SecondaryText),
+			Text:        base.Foreground(ctx.Theme.PrimaryText),
+		},
+		Cursor: textinput.CursorStyle{
+			Color: ctx.Theme.FaintText,
+			Shape: tea.CursorBar,
+			Blink: true,
+		},
+	})
+
+	ctl := cmpcontroller.New(ctx, inputbox.ModelOpts{TextInput: &ti})
+	ctl.SetMaxSuggestions(maxSuggestions)
+	m := Model{
+		ctx:        ctx,
+		cmpctl:     &ctl,
+		source:     &pickSource{},
+		confirmBox: prompt.NewModel(ctx),
+	}
+	m.syncStyles()
+	m.cmpctl.SetAutocompleteSource(m.source)
+
+	return m
+}
+
+// Start asks the prompts of the keybinding in order, then its confirmation.
+// input is the template input of the command, e.g. the row's RepoName.
+func (m *Model) Start(keybinding config.Keybinding, input map[string]any) tea.Cmd {
+	m.keybinding = keybinding
+	m.input = maps.Clone(input)
+	if m.input == nil {
+		m.input = map[string]any{}
+	}
+	m.step = 0
+	m.isOpen = true
+	m.confirming = false
+	return m.next()
+}
+
+func (m *Model) Close() {
+	m.isOpen = false
+	m.confirming = false
+	m.confirmBox.Reset()
+	m.confirmBox.Blur()
+	if m.cmpctl != nil {
+		m.cmpctl.Exit()
+	}
+}
+
+func (m *Model) IsOpen() bool {
+	return m.isOpen
+}
+
+// next asks the next prompt, the confirmation once all prompts are answered
+// or submits the input
+func (m *Model) next() tea.Cmd {
+	if m.step < len(m.keybinding.Prompts) {
+		return m.ask(m.keybinding.Prompts[m.step])
+	}
+
+	if m.keybinding.Confirm != "" && !m.confirming {
+		question, err := renderTemplate(m.keybinding.Confirm, m.input)
+		if err != nil {
+			m.Close()
+			return errCmd(fmt.Errorf("failed to parse confirm template %s: %w",
+				m.keybinding.Confirm, err))
+		}
+		m.cmpctl.Exit()
+		m.confirming = true
+		m.confirmBox.SetPrompt(fmt.Sprintf(" %s (y/N) ", question))
+		m.confirmBox.SetValue("")
+		return tea.Batch(m.confirmBox.Focus(), m.confirmBox.Init())
+	}
+
+	keybinding, input := m.keybinding, m.input
+	for _, p := range keybinding.Prompts {
+		if answer, ok := input[p.Name].(string); ok {
+			input[p.Name] = shell.Quote(answer)
+		}
+	}
+	m.Close()
+	return func() tea.Msg {
+		return SubmitMsg{Keybinding: keybinding, Input: input}
+	}
+}
+
+func (m *Model) ask(p config.KeybindingPrompt) tea.Cmd {
+	title := p.Title
+	if title == "" {
+		title = p.Name
+	}
+	m.cmpctl.SetInputPrompt(fmt.Sprintf(" %s: ", title))
+	opts := cmpcontroller.EnterOptions{
+		Mode:         cmpcontroller.ModeCommandPromptPick,
+		InitialValue: p.Default,
+		EnterFetch:   cmpcontroller.FetchNone,
+	}
+
+	m.source.options = nil
+	m.source.load = nil
+	switch p.Type {
+	case config.PromptChoice:
+		m.source.options = choiceOptions(p.Options)
+	case config.PromptLabel, config.PromptUser:
+		repo, ok := repoRef(m.input)
+		if !ok {
+			m.Close()
+			return errCmd(fmt.Errorf("the %s prompt needs the repo of the current row", p.Name))
+		}
+		opts.Repo = repo
+		opts.EnterFetch = cmpcontroller.FetchWithLoading
+		m.source.load = loadLabels
+		if p.Type == config.PromptUser {
+			m.source.load = loadUsers
+		}
+	default:
+		opts.Mode = cmpcontroller.ModeCommandPrompt
+	}
+
+	m.cmpctl.SetWidth(m.width())
+	cmd := m.cmpctl.Enter(opts)
+	if opts.Mode == cmpcontroller.ModeCommandPromptPick {
+		m.cmpctl.Filter()
+		m.cmpctl.ShowCompletions()
+	}
+	return cmd
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.isOpen {
+		return m, nil
+	}
+
+	if m.confirming {
+		keyMsg, ok := msg.(tea.KeyMsg)
+		if !ok {
+			var cmd tea.Cmd
+			m.confirmBox, cmd = m.confirmBox.Update(msg)
+			return m, cmd
+		}
+		switch keyMsg.String() {
+		case "ctrl+c", "esc":
+			m.Close()
+			return m, nil
+		case "enter":
+			if answer := m.confirmBox.Value(); answer == "y" || answer == "Y" {
+				return m, m.next()
+			}
+			m.Close()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.confirmBox, cmd = m.confirmBox.Update(msg)
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "enter" {
+		p := m.keybinding.Prompts[m.step]
+		answer := m.cmpctl.Value()
+		if m.cmpctl.Mode() == cmpcontroller.ModeCommandPromptPick {
+			answer = m.cmpctl.Selected()
+			if answer == "" {
+				return m, nil
+			}
+		}
+		m.input[p.Name] = answer
+		m.step++
+		return m, m.next()
+	}
+
+	cmd, _ := m.cmpctl.Update(msg)
+	// The controller exits when the prompt is cancelled
+	if !m.cmpctl.Active() {
+		m.Close()
+	}
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if !m.isOpen {
+		return ""
+	}
+
+	if m.confirming {
+		return m.ctx.Styles.Search.Root.
+			BorderForeground(m.ctx.Styles.Colors.OpenIssue).
+			Width(m.width()).
+			Render(m.confirmBox.View())
+	}
+
+	b := lipgloss.RoundedBorder()
+	if m.cmpctl.Mode() == cmpcontroller.ModeCommandPromptPick {
+		b.BottomLeft = lipgloss.RoundedBorder().MiddleLeft
+		b.BottomRight = lipgloss.RoundedBorder().MiddleRight
+	}
+	input := m.ctx.Styles.Search.Root.
+		Border(b, true).
+		BorderForeground(m.ctx.Styles.Colors.OpenIssue).
+		Render(m.cmpctl.View())
+
+	return lipgloss.JoinVertical(lipgloss.Left, input, m.cmpctl.ViewCompletions())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	if m.cmpctl == nil {
+		return
+	}
+	m.ctx = ctx
+	m.cmpctl.UpdateProgramContext(ctx)
+	m.cmpctl.SetWidth(m.width())
+	m.confirmBox.UpdateProgramContext(ctx)
+	m.syncStyles()
+}
+
+func (m *Model) width() int {
+	return max(20, min(maxWidth, m.ctx.ScreenWidth-4))
+}
+
+func (m *Model) syncStyles() {
+	selectStyles := m.ctx.Styles.Select
+	selectStyles.PopupStyle = m.ctx.Styles.Select.PopupStyle.BorderTop(false)
+	m.cmpctl.SetSelectStyles(selectStyles)
+}
+
+// repoRef is the repo of the row the command runs for
+func repoRef(input map[string]any) (cmpcontroller.RepoRef, bool) {
+	nameWithOwner, _ := input["RepoName"].(string)
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok || owner == "" || name == "" {
+		return cmpcontroller.RepoRef{}, false
+	}
+	return cmpcontroller.RepoRef{NameWithOwner: nameWithOwner, Owner: owner, Name: name}, true
+}
+
+func renderTemplate(text string, input map[string]any) (string, error) {
+	tmpl, err := template.New("keybinding_confirm").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"shellquote": shell.Quote}).
+		Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, input); err != nil {
+		return "", err
+	}
+	return buff.String(), nil
+}
+
+func errCmd(err error) tea.Cmd {
+	return func() tea.Msg {
+		return constants.ErrMsg{Err: err}
+	}
+}
@@ -0,0 +1,148 @@
+package commandprompt
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func newTestModel(t *testing.T) Model {
+	t.Helper()
+
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	thm := theme.ParseTheme(&cfg)
+	return NewModel(&context.ProgramContext{
+		Config:      &cfg,
+		Theme:       thm,
+		Styles:      context.InitStyles(thm),
+		ScreenWidth: 120,
+	})
+}
+
+var deployKeybinding = config.Keybinding{
+	Key:     "D",
+	Command: "deploy {{.PrNumber}} --env {{.Env}} --note {{.Note}}",
+	Confirm: "Deploy #{{.PrNumber}} to {{.Env}}?",
+	Prompts: []config.KeybindingPrompt{
+		{Name: "Env", Title: "Environment", Type: config.PromptChoice, Options: []string{
+			"staging", "production",
+		}},
+		{Name: "Note", Default: "hotfix"},
+	},
+}
+
+var deployInput = map[string]any{"RepoName": "dlvhdr/gh-dash", "PrNumber": 42}
+
+func typeText(m Model, text string) Model {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	return m
+}
+
+func pressEnter(m Model) (Model, tea.Cmd) {
+	return m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+}
+
+func TestPromptsAndConfirm(t *testing.T) {
+	m := newTestModel(t)
+	m.Start(deployKeybinding, deployInput)
+	require.True(t, m.IsOpen())
+	require.Contains(t, m.View(), "Environment")
+	require.Contains(t, m.View(), "production")
+
+	m = typeText(m, "prod")
+	m, _ = pressEnter(m)
+	require.Contains(t, m.View(), "Note")
+
+	m = typeText(m, " for #41")
+	m, _ = pressEnter(m)
+	require.True(t, m.IsOpen())
+	require.Contains(t, m.View(), "Deploy #42 to production? (y/N)")
+
+	m = typeText(m, "y")
+	m, cmd := pressEnter(m)
+	require.False(t, m.IsOpen())
+	require.NotNil(t, cmd)
+	require.Equal(t, SubmitMsg{
+		Keybinding: deployKeybinding,
+		Input: map[string]any{
+			"RepoName": "dlvhdr/gh-dash",
+			"PrNumber": 42,
+			"Env":      "'production'",
+			"Note":     "'hotfix for #41'",
+		},
+	}, cmd())
+
+	// The input of the caller is left as is
+	require.NotContains(t, deployInput, "Env")
+}
+
+func TestAnswersAreQuotedForTheShell(t *testing.T) {
+	m := newTestModel(t)
+	m.Start(config.Keybinding{
+		Key:     "n",
+		Command: "echo {{.Note}}",
+		Prompts: []config.KeybindingPrompt{{Name: "Note"}},
+	}, nil)
+
+	m = typeText(m, "it's $(rm -rf ~)")
+	_, cmd := pressEnter(m)
+
+	require.Equal(t, `'it'\''s $(rm -rf ~)'`, cmd().(SubmitMsg).Input["Note"])
+}
+
+func TestChoiceMustMatchAnOption(t *testing.T) {
+	m := newTestModel(t)
+	m.Start(deployKeybinding, deployInput)
+
+	m = typeText(m, "zzz")
+	m, cmd := pressEnter(m)
+
+	require.Nil(t, cmd)
+	require.True(t, m.IsOpen())
+	require.Contains(t, m.View(), "Environment")
+}
+
+func TestDeclineConfirm(t *testing.T) {
+	m := newTestModel(t)
+	m.Start(config.Keybinding{Key: "x", Command: "rm -rf build", Confirm: "Delete build?"}, nil)
+	require.Contains(t, m.View(), "Delete build? (y/N)")
+
+	m, cmd := pressEnter(m)
+
+	require.False(t, m.IsOpen())
+	require.Nil(t, cmd)
+}
+
+func TestEscCancelsPrompts(t *testing.T) {
+	m := newTestModel(t)
+	m.Start(deployKeybinding, deployInput)
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	require.False(t, m.IsOpen())
+}
+
+func TestRepoPromptWithoutRepo(t *testing.T) {
+	m := newTestModel(t)
+	cmd := m.Start(config.Keybinding{
+		Key:     "L",
+		Command: "echo {{.Label}}",
+		Prompts: []config.KeybindingPrompt{{Name: "Label", Type: config.PromptLabel}},
+	}, map[string]any{"RepoPath": "/tmp"})
+
+	require.False(t, m.IsOpen())
+	require.IsType(t, constants.ErrMsg{}, cmd())
+}
@@ -0,0 +1,89 @@
+package commandprompt
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+)
+
+// pickSource suggests the options of a prompt, the whole input being the
+// text to match. The options are either fixed or loaded from the row's repo.
+type pickSource struct {
+	options []fuzzyselect.Suggestion
+	load    func(ctx fuzzyselect.LoaderContext) ([]fuzzyselect.Suggestion, error)
+}
+
+func (src *pickSource) ExtractContext(input string, cursorPos tea.Position) fuzzyselect.Context {
+	return fuzzyselect.Context{
+		End:     tea.Position{X: len([]rune(input))},
+		Content: strings.TrimSpace(input),
+	}
+}
+
+func (src *pickSource) InsertSuggestion(
+	input string,
+	suggestion string,
+	contextStart tea.Position,
+	contextEnd tea.Position,
+) (newInput string, newCursorPos tea.Position) {
+	return suggestion, tea.Position{X: len([]rune(suggestion))}
+}
+
+func (*pickSource) ItemsToExclude(input string, cursorPos tea.Position) []string {
+	return nil
+}
+
+func (src *pickSource) Suggestions(input string, cursorPos tea.Position) []fuzzyselect.Suggestion {
+	return src.options
+}
+
+func (src *pickSource) LoadSuggestions(ctx fuzzyselect.LoaderContext) error {
+	if src.load == nil {
+		return nil
+	}
+	options, err := src.load(ctx)
+	src.options = options
+	return err
+}
+
+func choiceOptions(options []string) []fuzzyselect.Suggestion {
+	suggestions := make([]fuzzyselect.Suggestion, 0, len(options))
+	for _, option := range options {
+		suggestions = append(suggestions, fuzzyselect.Suggestion{Value: option})
+	}
+	return suggestions
+}
+
+func loadLabels(ctx fuzzyselect.LoaderContext) ([]fuzzyselect.Suggestion, error) {
+	labels, err := data.FetchRepoLabels(fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName), ctx.Host)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]fuzzyselect.Suggestion, 0, len(labels))
+	for _, label := range labels {
+		suggestions = append(suggestions, fuzzyselect.Suggestion{
+			Value:  label.Name,
+			Detail: strings.TrimSpace(label.Description),
+		})
+	}
+	return suggestions, nil
+}
+
+func loadUsers(ctx fuzzyselect.LoaderContext) ([]fuzzyselect.Suggestion, error) {
+	users, err := data.FetchRepoUsers(ctx.RepoOwner, ctx.RepoName, ctx.Host)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]fuzzyselect.Suggestion, 0, len(users))
+	for _, user := range users {
+		suggestions = append(suggestions, fuzzyselect.Suggestion{
+			Value:  user.Login,
+			Detail: user.Name,
+		})
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,95 @@
+package discussionrow
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Discussion struct {
+	Ctx            *context.ProgramContext
+	Data           data.DiscussionData
+	ShowAuthorIcon bool
+}
+
+func (d *Discussion) ToTableRow() table.Row {
+	return table.Row{
+		d.renderStatus(),
+		d.renderRepoName(),
+		d.renderTitle(),
+		d.renderAuthor(),
+		d.renderCategory(),
+		d.renderNumComments(),
+		d.renderUpvotes(),
+		d.renderUpdateAt(),
+		d.renderCreatedAt(),
+	}
+}
+
+func (d *Discussion) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(d.Ctx)
+}
+
+func (d *Discussion) renderStatus() string {
+	switch {
+	case d.Data.IsAnswered:
+		return lipgloss.NewStyle().Foreground(d.Ctx.Theme.SuccessText).Render(constants.AnsweredIcon)
+	case d.Data.Closed:
+		return d.Ctx.Styles.Common.FaintTextStyle.Render(constants.DiscussionIcon)
+	default:
+		return lipgloss.NewStyle().Foreground(d.Ctx.Styles.Colors.OpenIssue).Render(constants.DiscussionIcon)
+	}
+}
+
+func (d *Discussion) renderRepoName() string {
+	return d.getTextStyle().Render(d.Data.Repository.Name)
+}
+
+func (d *Discussion) renderTitle() string {
+	return components.RenderIssueTitle(
+		d.Ctx,
+		d.Data.GetState(),
+		d.Data.Title,
+		d.Data.Number,
+	)
+}
+
+func (d *Discussion) renderAuthor() string {
+	return d.getTextStyle().Render(d.Data.GetAuthor(d.Ctx.Theme, d.ShowAuthorIcon))
+}
+
+func (d *Discussion) renderCategory() string {
+	return d.getTextStyle().Render(d.Data.Category.Name)
+}
+
+func (d *Discussion) renderNumComments() string {
+	return d.getTextStyle().Render(fmt.Sprintf("%d", d.Data.Comments.TotalCount))
+}
+
+func (d *Discussion) renderUpvotes() string {
+	return d.getTextStyle().Render(fmt.Sprintf("%d", d.Data.UpvoteCount))
+}
+
+func (d *Discussion) renderUpdateAt() string {
+	return d.getTextStyle().Render(d.formatTime(d.Data.UpdatedAt))
+}
+
+func (d *Discussion) renderCreatedAt() string {
+	return d.getTextStyle().Render(d.formatTime(d.Data.CreatedAt))
+}
+
+func (d *Discussion) formatTime(t time.Time) string {
+	timeFormat := d.Ctx.Config.Defaults.DateFormat
+	if timeFormat == "" || timeFormat == "relative" {
+		return utils.TimeElapsed(t)
+	}
+	return t.Format(timeFormat)
+}
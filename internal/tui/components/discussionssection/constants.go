@@ -0,0 +1,12 @@
+package discussionssection
+
+import "charm.land/lipgloss/v2"
+
+var (
+	statusCellWidth      = 3
+	repoCellWidth        = 15
+	authorCellWidth      = 12
+	categoryCellWidth    = 14
+	numCommentsCellWidth = 6
+	timeCellWidth        = lipgloss.Width("2mo  ")
+)
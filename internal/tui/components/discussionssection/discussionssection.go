@@ -0,0 +1,426 @@
+package discussionssection
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "discussion"
+
+type Model struct {
+	section.BaseModel
+	Discussions []data.DiscussionData
+	// batch fetches the first page along with the other sections' ones
+	batch *data.SearchBatch[data.DiscussionsResponse]
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.DiscussionsSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	m.Discussions = []data.DiscussionData{}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+
+		if m.IsQuickFilterFocused() {
+			cmd = m.UpdateQuickFilter(msg)
+			break
+		}
+
+		if m.IsSearchFocused() {
+			switch msg.String() {
+			case "ctrl+c", "esc":
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case "enter":
+				m.SearchValue = m.SearchBar.Value()
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+			}
+
+			break
+		}
+
+		if m.IsPromptConfirmationFocused() {
+			switch msg.String() {
+			case "ctrl+c", "esc", "enter":
+				m.PromptConfirmationBox.Reset()
+				cmd = m.SetIsPromptConfirmationShown(false)
+				return m, cmd
+			}
+			break
+		}
+
+	case tasks.UpdateDiscussionMsg:
+		for i, currDiscussion := range m.Discussions {
+			if currDiscussion.Url == msg.DiscussionUrl {
+				m.Discussions[i] = applyUpdate(currDiscussion, msg)
+				m.SetIsLoading(false)
+				m.Table.SetRows(m.BuildRows())
+				break
+			}
+		}
+
+	case SectionDiscussionsFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			if m.PageInfo != nil {
+				m.Discussions = append(m.Discussions, msg.Discussions...)
+			} else {
+				m.Discussions = msg.Discussions
+			}
+			m.TotalCount = msg.TotalCount
+			m.SetIsLoading(false)
+			m.PageInfo = &msg.PageInfo
+			m.Table.SetRows(m.BuildRows())
+			m.UpdateLastUpdated(time.Now())
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+	}
+
+	m.ApplyQuickFilter(m.FilterableRows())
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
+	m.PromptConfirmationBox = prompt
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(cmd, searchCmd, promptCmd, tableCmd)
+}
+
+// applyUpdate returns the discussion with the comment, reply or answer of msg
+// applied, so the sidebar reflects it without refetching the section
+func applyUpdate(
+	discussion data.DiscussionData,
+	msg tasks.UpdateDiscussionMsg,
+) data.DiscussionData {
+	comments := make([]data.DiscussionComment, len(discussion.Comments.Nodes))
+	copy(comments, discussion.Comments.Nodes)
+
+	if msg.NewComment != nil {
+		if msg.ReplyToId == "" {
+			comments = append(comments, *msg.NewComment)
+			discussion.Comments.TotalCount++
+		} else {
+			for i, comment := range comments {
+				if comment.Id != msg.ReplyToId {
+					continue
+				}
+				replies := make([]data.DiscussionReply, len(comment.Replies.Nodes), len(comment.Replies.Nodes)+1)
+				copy(replies, comment.Replies.Nodes)
+				comments[i].Replies.Nodes = append(replies, data.DiscussionReply{
+					Id:        msg.NewComment.Id,
+					Author:    msg.NewComment.Author,
+					Body:      msg.NewComment.Body,
+					CreatedAt: msg.NewComment.CreatedAt,
+				})
+				comments[i].Replies.TotalCount++
+				break
+			}
+		}
+	}
+
+	if msg.AnswerId != nil {
+		for i := range comments {
+			comments[i].IsAnswer = comments[i].Id == *msg.AnswerId
+		}
+		discussion.IsAnswered = *msg.AnswerId != ""
+	}
+
+	discussion.Comments.Nodes = comments
+	return discussion
+}
+
+func GetSectionColumns() []table.Column {
+	return []table.Column{
+		{
+			Title: "",
+			Width: &statusCellWidth,
+		},
+		{
+			Title: "",
+			Width: &repoCellWidth,
+		},
+		{
+			Title: "Title",
+			Grow:  utils.BoolPtr(true),
+		},
+		{
+			Title: "Author",
+			Width: &authorCellWidth,
+		},
+		{
+			Title: "Category",
+			Width: &categoryCellWidth,
+		},
+		{
+			Title: constants.CommentsIcon,
+			Width: &numCommentsCellWidth,
+		},
+		{
+			Title: "",
+			Width: &numCommentsCellWidth,
+		},
+		{
+			Title: "󱦻",
+			Width: &timeCellWidth,
+		},
+		{
+			Title: "󱡢",
+			Width: &timeCellWidth,
+		},
+	}
+}
+
+func (m Model) BuildRows() []table.Row {
+	var rows []table.Row
+	for _, currDiscussion := range m.Discussions {
+		discussionModel := discussionrow.Discussion{
+			Ctx:            m.Ctx,
+			Data:           currDiscussion,
+			ShowAuthorIcon: m.ShowAuthorIcon,
+		}
+		rows = append(rows, discussionModel.ToTableRow())
+	}
+
+	if rows == nil {
+		rows = []table.Row{}
+	}
+
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.Discussions)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	idx := m.Table.GetCurrItem()
+	if idx < 0 || idx >= len(m.Discussions) {
+		return nil
+	}
+	discussion := m.Discussions[idx]
+	return &discussion
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	startCursor := time.Now().String()
+	if m.PageInfo != nil {
+		startCursor = m.PageInfo.StartCursor
+	}
+	taskId := fmt.Sprintf("fetching_discussions_%d_%s", m.Id, startCursor)
+	isFirstFetch := m.LastFetchTaskId == ""
+	m.LastFetchTaskId = taskId
+	task := context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf(`Fetching discussions for "%s"`, m.Config.Title),
+		FinishedText: fmt.Sprintf(
+			`Discussions for "%s" have been fetched`,
+			m.Config.Title,
+		),
+		State: context.TaskStart,
+		Error: nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.DiscussionsLimit
+	}
+	filters := m.GetFilters()
+	isFirstPage := m.PageInfo == nil
+
+	batch, batchIndex := m.batch, -1
+	m.batch = nil
+	if batch != nil && isFirstPage {
+		// A batch that's already being fetched leaves the index at -1, so the
+		// section is fetched on its own
+		batchIndex, _ = batch.Add(data.SearchRequest{Query: filters, Limit: *limit, Host: m.Config.Host})
+	}
+
+	fetchCmd := func() tea.Msg {
+		var res data.DiscussionsResponse
+		var err error
+		if batchIndex != -1 {
+			res, err = batch.Result(batchIndex)
+		} else {
+			res, err = data.FetchDiscussions(filters, *limit, m.PageInfo, m.Config.Host)
+		}
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+		if isFirstPage {
+			data.SaveCachedDiscussions(filters, *limit, m.Config.Host, res)
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionDiscussionsFetchedMsg{
+				Discussions: res.Discussions,
+				TotalCount:  res.TotalCount,
+				PageInfo:    res.PageInfo,
+				TaskId:      taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	if isFirstFetch {
+		m.loadCachedRows(filters, *limit)
+	}
+
+	return cmds
+}
+
+// loadCachedRows shows the discussions cached by the last run while the
+// section refetches them.
+func (m *Model) loadCachedRows(filters string, limit int) {
+	res, fetchedAt, ok := data.LoadCachedDiscussions(filters, limit, m.Config.Host)
+	if !ok {
+		return
+	}
+
+	m.Discussions = res.Discussions
+	m.TotalCount = res.TotalCount
+	m.Table.SetRows(m.BuildRows())
+	m.Table.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Discussions = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.DiscussionsSections
+	fetchDiscussionsCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	batch := data.NewDiscussionsBatch()
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1,
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		) // 0 is the search section
+		sectionModel.batch = batch
+		sections = append(sections, &sectionModel)
+		fetchDiscussionsCmds = append(
+			fetchDiscussionsCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchDiscussionsCmds...)
+}
+
+type SectionDiscussionsFetchedMsg struct {
+	Discussions []data.DiscussionData
+	TotalCount  int
+	PageInfo    data.PageInfo
+	TaskId      string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Discussion"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Discussions"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v • Fetched %v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+			len(m.Table.Rows),
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,76 @@
+package discussionssection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+func newDiscussion() data.DiscussionData {
+	return data.DiscussionData{
+		Url: "https://github.com/dlvhdr/gh-dash/discussions/1",
+		Comments: data.DiscussionComments{
+			Nodes: []data.DiscussionComment{
+				{Id: "c1"},
+				{Id: "c2", IsAnswer: true},
+			},
+			TotalCount: 2,
+		},
+		IsAnswered: true,
+	}
+}
+
+func TestApplyUpdate_NewComment(t *testing.T) {
+	discussion := newDiscussion()
+	updated := applyUpdate(discussion, tasks.UpdateDiscussionMsg{
+		DiscussionUrl: discussion.Url,
+		NewComment:    &data.DiscussionComment{Id: "c3", Body: "thanks"},
+	})
+
+	require.Len(t, updated.Comments.Nodes, 3)
+	require.Equal(t, "c3", updated.Comments.Nodes[2].Id)
+	require.Equal(t, 3, updated.Comments.TotalCount)
+	require.Len(t, discussion.Comments.Nodes, 2, "the original discussion shouldn't change")
+}
+
+func TestApplyUpdate_NewReply(t *testing.T) {
+	discussion := newDiscussion()
+	updated := applyUpdate(discussion, tasks.UpdateDiscussionMsg{
+		DiscussionUrl: discussion.Url,
+		NewComment:    &data.DiscussionComment{Id: "r1", Body: "same here"},
+		ReplyToId:     "c1",
+	})
+
+	require.Len(t, updated.Comments.Nodes, 2)
+	require.Equal(t, 2, updated.Comments.TotalCount)
+	replies := updated.Comments.Nodes[0].Replies
+	require.Len(t, replies.Nodes, 1)
+	require.Equal(t, "same here", replies.Nodes[0].Body)
+	require.Equal(t, 1, replies.TotalCount)
+	require.Empty(t, discussion.Comments.Nodes[0].Replies.Nodes,
+		"the original discussion shouldn't change")
+}
+
+func TestApplyUpdate_Answer(t *testing.T) {
+	discussion := newDiscussion()
+
+	answerId := "c1"
+	updated := applyUpdate(discussion, tasks.UpdateDiscussionMsg{
+		DiscussionUrl: discussion.Url,
+		AnswerId:      &answerId,
+	})
+	require.True(t, updated.IsAnswered)
+	require.True(t, updated.Comments.Nodes[0].IsAnswer)
+	require.False(t, updated.Comments.Nodes[1].IsAnswer)
+
+	noAnswer := ""
+	updated = applyUpdate(updated, tasks.UpdateDiscussionMsg{
+		DiscussionUrl: discussion.Url,
+		AnswerId:      &noAnswer,
+	})
+	require.False(t, updated.IsAnswered)
+	require.False(t, updated.Comments.Nodes[0].IsAnswer)
+}
@@ -0,0 +1,24 @@
+package discussionssection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// FilterableRows returns the fields the quick filter matches the discussions
+// on, their category taking the place of a branch
+func (m *Model) FilterableRows() []section.FilterableRow {
+	rows := make([]section.FilterableRow, 0, len(m.Discussions))
+	for _, discussion := range m.Discussions {
+		row := section.FilterableRow{
+			Title:  discussion.Title,
+			Author: discussion.Author.Login,
+			Repo:   discussion.GetRepoNameWithOwner(),
+			Branch: discussion.Category.Name,
+		}
+		for _, label := range discussion.Labels.Nodes {
+			row.Labels = append(row.Labels, label.Name)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,241 @@
+package discussionview
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/glamour/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// commentsState holds the navigation state of the comments of a discussion.
+// It's kept per discussion and reset whenever the viewed discussion changes.
+type commentsState struct {
+	discussionUrl  string
+	isNavigating   bool
+	cursor         int
+	replyCommentId string
+}
+
+func (m *Model) IsNavigatingComments() bool {
+	return m.discussion != nil && m.comments.isNavigating &&
+		m.comments.discussionUrl == m.discussion.Url
+}
+
+// StartNavigatingComments lets the user pick a comment to reply to or to mark
+// as the answer
+func (m *Model) StartNavigatingComments() tea.Cmd {
+	if m.discussion == nil || len(m.discussion.Comments.Nodes) == 0 {
+		return nil
+	}
+
+	if m.comments.discussionUrl != m.discussion.Url {
+		m.comments = commentsState{discussionUrl: m.discussion.Url}
+	}
+	m.comments.isNavigating = true
+	m.comments.cursor = min(m.comments.cursor, len(m.discussion.Comments.Nodes)-1)
+	return nil
+}
+
+func (m *Model) selectedComment() *data.DiscussionComment {
+	if m.comments.cursor >= len(m.discussion.Comments.Nodes) {
+		return nil
+	}
+	return &m.discussion.Comments.Nodes[m.comments.cursor]
+}
+
+func (m *Model) updateComments(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.DiscussionCommentKeys.Down):
+		m.comments.cursor = min(m.comments.cursor+1, max(0, len(m.discussion.Comments.Nodes)-1))
+	case key.Matches(msg, keys.DiscussionCommentKeys.Up):
+		m.comments.cursor = max(m.comments.cursor-1, 0)
+	case key.Matches(msg, keys.DiscussionCommentKeys.Reply):
+		return m.enterReply()
+	case key.Matches(msg, keys.DiscussionCommentKeys.ToggleAnswer):
+		return m.toggleAnswer()
+	case key.Matches(msg, keys.DiscussionCommentKeys.Back):
+		m.comments.isNavigating = false
+	}
+
+	return nil
+}
+
+func (m *Model) enterReply() tea.Cmd {
+	comment := m.selectedComment()
+	if comment == nil {
+		return nil
+	}
+	m.comments.replyCommentId = comment.Id
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeThreadReply,
+		Prompt:                           constants.DiscussionReplyPrompt,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+}
+
+func (m *Model) submitReply(sid tasks.SectionIdentifier, body string) tea.Cmd {
+	if m.comments.replyCommentId == "" {
+		return nil
+	}
+
+	replyToId := m.comments.replyCommentId
+	m.comments.replyCommentId = ""
+	return tasks.CommentOnDiscussion(m.ctx, sid, *m.discussion, replyToId, body)
+}
+
+func (m *Model) toggleAnswer() tea.Cmd {
+	comment := m.selectedComment()
+	if comment == nil {
+		return nil
+	}
+
+	if !m.discussion.Category.IsAnswerable {
+		m.ctx.Error = fmt.Errorf("discussions in %q can't be answered", m.discussion.Category.Name)
+		return nil
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: discussionssection.SectionType}
+	return tasks.SetDiscussionAnswer(m.ctx, sid, *m.discussion, comment.Id, !comment.IsAnswer)
+}
+
+// CommentsCursorLine returns the line of the rendered view the selected
+// comment starts on, so the sidebar can keep it visible
+func (m *Model) CommentsCursorLine() int {
+	if !m.IsNavigatingComments() {
+		return 0
+	}
+
+	rendered := m.renderCommentList()
+	line := lipgloss.Height(m.viewHeader()) + lipgloss.Height(m.renderBody()) + 1 +
+		lipgloss.Height(m.renderCommentsTitle())
+	for i := 0; i < m.comments.cursor && i < len(rendered); i++ {
+		line += lipgloss.Height(rendered[i])
+	}
+	return line
+}
+
+func (m *Model) renderComments() string {
+	if len(m.discussion.Comments.Nodes) == 0 {
+		return lipgloss.NewStyle().Italic(true).Render("No comments...")
+	}
+
+	s := strings.Builder{}
+	s.WriteString(m.renderCommentsTitle())
+	s.WriteString("\n")
+	s.WriteString(lipgloss.JoinVertical(lipgloss.Left, m.renderCommentList()...))
+
+	if !m.IsNavigatingComments() {
+		s.WriteString("\n\n")
+		s.WriteString(m.ctx.Styles.Common.FaintTextStyle.Render(
+			fmt.Sprintf("Press %s to reply to a comment or mark it as the answer",
+				keys.DiscussionKeys.Comments.Help().Key),
+		))
+	}
+
+	return s.String()
+}
+
+func (m *Model) renderCommentsTitle() string {
+	return m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(
+		fmt.Sprintf(
+			"%s  %d comments",
+			constants.CommentsIcon,
+			m.discussion.Comments.TotalCount,
+		))
+}
+
+// renderCommentList renders each top level comment along with its replies
+func (m *Model) renderCommentList() []string {
+	width := m.getIndentedContentWidth()
+	markdownRenderer := markdown.GetMarkdownRenderer(max(0, width-2), m.ctx)
+	replyRenderer := markdown.GetMarkdownRenderer(max(0, width-4), m.ctx)
+
+	rendered := make([]string, 0, len(m.discussion.Comments.Nodes))
+	for i, comment := range m.discussion.Comments.Nodes {
+		isSelected := m.IsNavigatingComments() && i == m.comments.cursor
+		rendered = append(rendered, m.renderComment(comment, isSelected, markdownRenderer, replyRenderer))
+	}
+	return rendered
+}
+
+func (m *Model) renderComment(
+	comment data.DiscussionComment,
+	isSelected bool,
+	markdownRenderer glamour.TermRenderer,
+	replyRenderer glamour.TermRenderer,
+) string {
+	faint := m.ctx.Styles.Common.FaintTextStyle
+
+	header := []string{
+		m.ctx.Styles.Common.MainTextStyle.Render(comment.Author.Login),
+		" ",
+		faint.Render(utils.TimeElapsed(comment.CreatedAt)),
+	}
+	if comment.UpvoteCount > 0 {
+		header = append(header, faint.Render(fmt.Sprintf(" · %d upvotes", comment.UpvoteCount)))
+	}
+	if comment.IsAnswer {
+		header = append(header, " ", m.ctx.Styles.Common.SuccessGlyph, " ",
+			lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText).Render("Answer"))
+	}
+
+	parts := []string{
+		lipgloss.JoinHorizontal(lipgloss.Top, header...),
+		m.renderMarkdown(comment.Body, markdownRenderer),
+	}
+
+	for _, reply := range comment.Replies.Nodes {
+		replyHeader := lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.ctx.Styles.Common.MainTextStyle.Render(reply.Author.Login),
+			" ",
+			faint.Render(utils.TimeElapsed(reply.CreatedAt)),
+		)
+		parts = append(parts, lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(m.ctx.Theme.FaintBorder).
+			PaddingLeft(1).
+			Render(lipgloss.JoinVertical(lipgloss.Left,
+				replyHeader, m.renderMarkdown(reply.Body, replyRenderer))))
+	}
+	if hidden := comment.Replies.TotalCount - len(comment.Replies.Nodes); hidden > 0 {
+		parts = append(parts, faint.Render(fmt.Sprintf("%d more replies", hidden)))
+	}
+
+	borderColor := m.ctx.Theme.FaintBorder
+	if isSelected {
+		borderColor = m.ctx.Theme.PrimaryBorder
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.ThickBorder(), false, false, false, true).
+		BorderForeground(borderColor).
+		PaddingLeft(1).
+		MarginTop(1).
+		Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
+func (m *Model) renderMarkdown(body string, markdownRenderer glamour.TermRenderer) string {
+	rendered, err := markdownRenderer.Render(htmlCommentRegex.ReplaceAllString(body, ""))
+	if err != nil {
+		return body
+	}
+	return strings.TrimRight(rendered, "\n")
+}
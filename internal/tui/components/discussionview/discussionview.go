@@ -0,0 +1,275 @@
+package discussionview
+
+import (
+	"fmt"
+	"image/color"
+	"regexp"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+var htmlCommentRegex = regexp.MustCompile("(?U)<!--(.|[[:space:]])*-->")
+
+type Model struct {
+	ctx        *context.ProgramContext
+	discussion *data.DiscussionData
+	sectionId  int
+	width      int
+	editor     cmpcontroller.Controller
+	comments   commentsState
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ta := inputbox.DefaultTextArea(ctx)
+	cmp := cmpcontroller.New(ctx, inputbox.ModelOpts{TextArea: &ta})
+
+	return Model{
+		ctx:        ctx,
+		discussion: nil,
+		editor:     cmp,
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	cmd, handled := m.editor.Update(msg)
+
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "ctrl+d" {
+		value := m.editor.Value()
+		mode := m.editor.Mode()
+		m.editor.Exit()
+		if m.discussion == nil || len(strings.TrimSpace(value)) == 0 {
+			return m, nil
+		}
+
+		sid := tasks.SectionIdentifier{Id: m.sectionId, Type: discussionssection.SectionType}
+		switch mode {
+		case cmpcontroller.ModeComment:
+			return m, tasks.CommentOnDiscussion(m.ctx, sid, *m.discussion, "", value)
+		case cmpcontroller.ModeThreadReply:
+			return m, m.submitReply(sid, value)
+		}
+		return m, nil
+	}
+	if handled {
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.IsNavigatingComments() {
+		return m, m.updateComments(keyMsg)
+	}
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if m.discussion == nil {
+		return ""
+	}
+
+	s := strings.Builder{}
+	s.WriteString(m.viewHeader())
+	s.WriteString(m.renderBody())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderComments())
+
+	if m.editor.Mode() != cmpcontroller.ModeNone {
+		s.WriteString("\n")
+		s.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
+}
+
+// viewHeader renders everything shown above the body of the discussion
+func (m *Model) viewHeader() string {
+	s := strings.Builder{}
+	s.WriteString(m.renderFullNameAndNumber())
+	s.WriteString("\n")
+	s.WriteString(m.renderTitle())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderStatusPill())
+	s.WriteString(" ")
+	s.WriteString(m.renderCategory())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderAuthor())
+	s.WriteString("\n\n")
+
+	labels := m.renderLabels()
+	if labels != "" {
+		s.WriteString(labels)
+		s.WriteString("\n\n")
+	}
+	return s.String()
+}
+
+func (m *Model) ViewCompletions() string {
+	if m.discussion == nil {
+		return ""
+	}
+
+	return m.editor.ViewCompletions()
+}
+
+func (m *Model) InputBoxLineFromButton() int {
+	return m.editor.LineFromBottom()
+}
+
+func (m *Model) renderFullNameAndNumber() string {
+	return common.RenderPreviewHeader(m.ctx.Theme, m.width,
+		fmt.Sprintf("#%d · %s", m.discussion.GetNumber(), m.discussion.GetRepoNameWithOwner()))
+}
+
+func (m *Model) renderTitle() string {
+	return common.RenderPreviewTitle(m.ctx.Theme, m.ctx.Styles.Common, m.width, m.discussion.Title)
+}
+
+func (m *Model) renderStatusPill() string {
+	var bgColor color.Color
+	content := ""
+	switch {
+	case m.discussion.IsAnswered:
+		bgColor = m.ctx.Styles.Colors.MergedPR.Dark
+		content = constants.AnsweredIcon + " Answered"
+	case m.discussion.Closed:
+		bgColor = m.ctx.Styles.Colors.ClosedIssue.Dark
+		content = constants.DiscussionIcon + " Closed"
+	default:
+		bgColor = m.ctx.Styles.Colors.OpenIssue.Dark
+		content = constants.DiscussionIcon + " Open"
+	}
+
+	return m.ctx.Styles.PrView.PillStyle.
+		BorderForeground(bgColor).
+		Background(bgColor).
+		Render(content)
+}
+
+func (m *Model) renderCategory() string {
+	return m.ctx.Styles.Common.FaintTextStyle.Render(m.discussion.Category.Name)
+}
+
+func (m *Model) renderAuthor() string {
+	authorAssociation := m.discussion.AuthorAssociation
+	if authorAssociation == "" {
+		authorAssociation = "unknown role"
+	}
+	time := lipgloss.NewStyle().Render(utils.TimeElapsed(m.discussion.CreatedAt))
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		" by ",
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Render(
+			lipgloss.NewStyle().Bold(true).Render("@"+m.discussion.Author.Login)),
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render(
+			lipgloss.JoinHorizontal(lipgloss.Top, " ⋅ ", time, " ago", " ⋅ ")),
+		lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render(
+			lipgloss.JoinHorizontal(lipgloss.Top,
+				data.GetAuthorRoleIcon(m.discussion.AuthorAssociation, m.ctx.Theme),
+				" ", strings.ToLower(authorAssociation)),
+		),
+	)
+}
+
+func (m *Model) renderBody() string {
+	width := m.getIndentedContentWidth()
+	body := strings.TrimSpace(htmlCommentRegex.ReplaceAllString(m.discussion.Body, ""))
+	if body == "" {
+		return lipgloss.NewStyle().
+			Italic(true).
+			Foreground(m.ctx.Theme.FaintText).
+			Render("No description provided.")
+	}
+
+	markdownRenderer := markdown.GetMarkdownRenderer(width, m.ctx)
+	rendered, err := markdownRenderer.Render(body)
+	if err != nil {
+		return ""
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxWidth(width).
+		Align(lipgloss.Left).
+		Render(rendered)
+}
+
+func (m *Model) renderLabels() string {
+	return common.RenderLabels(m.discussion.Labels.Nodes, common.LabelOpts{
+		Width:     m.getIndentedContentWidth(),
+		PillStyle: m.ctx.Styles.PrView.PillStyle,
+	})
+}
+
+func (m *Model) getIndentedContentWidth() int {
+	return m.width - 6
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+	m.editor.SetWidth(
+		m.getIndentedContentWidth() - m.ctx.Styles.Sidebar.InputBox.GetHorizontalFrameSize(),
+	)
+}
+
+func (m *Model) SetSectionId(id int) {
+	m.sectionId = id
+}
+
+func (m *Model) SetRow(d *data.DiscussionData) {
+	m.discussion = d
+}
+
+func (m *Model) IsTextInputBoxFocused() bool {
+	return m.editor.Active()
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.editor.UpdateProgramContext(ctx)
+	m.editor.SetSelectStyles(ctx.Styles.Select)
+}
+
+func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
+	if m.discussion == nil {
+		return nil
+	}
+
+	if !isCommenting {
+		if m.editor.Mode() == cmpcontroller.ModeComment {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeComment,
+		Prompt:                           constants.CommentPrompt,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+}
+
+func (m *Model) repoRef() cmpcontroller.RepoRef {
+	owner, repo := m.discussion.GetRepoNameAndOwner()
+	return cmpcontroller.RepoRef{
+		NameWithOwner: m.discussion.GetRepoNameWithOwner(),
+		Owner:         owner,
+		Name:          repo,
+		Host:          data.HostOf(m.discussion.Url),
+	}
+}
@@ -2,8 +2,10 @@ package footer
 
 import (
 	"fmt"
+	"math"
 	"path"
 	"strings"
+	"time"
 
 	bbHelp "charm.land/bubbles/v2/help"
 	"charm.land/lipgloss/v2"
@@ -11,6 +13,7 @@ import (
 	zone "github.com/lrstanley/bubblezone/v2"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
@@ -70,6 +73,7 @@ func (m Model) View() string {
 		if m.rightSection != nil {
 			rightSection = *m.rightSection
 		}
+		rateLimit := m.renderRateLimit()
 		spacing := lipgloss.NewStyle().
 			Background(m.ctx.Theme.SelectedBackground).
 			Render(
@@ -80,6 +84,7 @@ func (m Model) View() string {
 							viewSwitcher,
 						)-lipgloss.Width(leftSection)-
 							lipgloss.Width(rightSection)-
+							lipgloss.Width(rateLimit)-
 							lipgloss.Width(
 								helpIndicator,
 							)-lipgloss.Width(donationIndicator),
@@ -87,7 +92,7 @@ func (m Model) View() string {
 
 		footer = m.ctx.Styles.Common.FooterStyle.
 			Render(lipgloss.JoinHorizontal(lipgloss.Top, viewSwitcher, leftSection, spacing,
-				rightSection, donationIndicator, helpIndicator))
+				rightSection, rateLimit, donationIndicator, helpIndicator))
 	}
 
 	if m.ShowAll {
@@ -135,6 +140,9 @@ func (m *Model) renderViewButton(view config.ViewType) string {
 	case config.IssuesView:
 		icon = ""
 		label = " Issues"
+	case config.DiscussionsView:
+		icon = constants.DiscussionIcon
+		label = " Discussions"
 	}
 
 	if isActive {
@@ -161,6 +169,20 @@ func (m *Model) renderViewButton(view config.ViewType) string {
 	return m.ctx.Styles.ViewSwitcher.InactiveView.Render(icon + label)
 }
 
+// renderDiscussionsViewButton renders the discussions view button, which is
+// only shown once discussions sections are configured
+func (m *Model) renderDiscussionsViewButton() string {
+	if m.ctx.Config == nil || len(m.ctx.Config.DiscussionsSections) == 0 {
+		return ""
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		m.ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
+		m.renderViewButton(config.DiscussionsView),
+	)
+}
+
 func (m *Model) renderViewSwitcher(ctx *context.ProgramContext) string {
 	var repo string
 	if m.ctx.RepoPath != "" {
@@ -184,6 +206,7 @@ func (m *Model) renderViewSwitcher(ctx *context.ProgramContext) string {
 		m.renderViewButton(config.PRsView),
 		ctx.Styles.ViewSwitcher.ViewsSeparator.Render(viewSeparator),
 		m.renderViewButton(config.IssuesView),
+		m.renderDiscussionsViewButton(),
 		lipgloss.NewStyle().Background(ctx.Styles.Common.FooterStyle.GetBackground()).Foreground(
 			ctx.Styles.ViewSwitcher.ViewsSeparator.GetBackground()).Render(" "),
 		repo,
@@ -195,6 +218,33 @@ func (m *Model) renderViewSwitcher(ctx *context.ProgramContext) string {
 	return ctx.Styles.ViewSwitcher.Root.Render(view)
 }
 
+// renderRateLimit renders the lowest rate limit budget left, along with when
+// it resets once it runs low
+func (m *Model) renderRateLimit() string {
+	rl, ok := data.LowestRateLimit(time.Now())
+	if !ok {
+		return ""
+	}
+
+	style := m.ctx.Styles.Common.FooterStyle.Foreground(m.ctx.Theme.FaintText).PaddingRight(1)
+	switch {
+	case rl.IsCritical():
+		style = style.Foreground(m.ctx.Theme.ErrorText)
+	case rl.IsLow():
+		style = style.Foreground(m.ctx.Theme.WarningText)
+	}
+
+	text := fmt.Sprintf("%s %d/%d", constants.RateLimitIcon, rl.Remaining, rl.Limit)
+	if rl.Host != "" {
+		text = fmt.Sprintf("%s %s", text, rl.Host)
+	}
+	if rl.IsLow() {
+		minutes := int(math.Ceil(time.Until(rl.ResetAt).Minutes()))
+		text = fmt.Sprintf("%s, resets in %dm", text, max(1, minutes))
+	}
+	return style.Render(text)
+}
+
 func (m *Model) SetLeftSection(leftSection string) {
 	*m.leftSection = leftSection
 }
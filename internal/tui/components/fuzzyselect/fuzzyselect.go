@@ -231,6 +231,11 @@ func (m *Model) HasSuggestions() bool {
 	return len(m.filtered) > 0
 }
 
+// SetMaxVisible sets how many suggestions are shown at most
+func (m *Model) SetMaxVisible(n int) {
+	m.maxVisible = max(1, n)
+}
+
 func (m *Model) Width() int {
 	return m.width
 }
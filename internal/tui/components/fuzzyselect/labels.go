@@ -174,7 +174,7 @@ func CurrentLabels(value string) []string {
 }
 
 func (src *LabelSource) LoadSuggestions(ctx LoaderContext) error {
-	labels, err := data.FetchRepoLabels(fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName))
+	labels, err := data.FetchRepoLabels(fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName), ctx.Host)
 	src.Labels = labels
 	return err
 }
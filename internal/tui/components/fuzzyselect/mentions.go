@@ -139,7 +139,7 @@ func isWordBoundary(r rune) bool {
 }
 
 func (src *UserMentionSource) LoadSuggestions(ctx LoaderContext) error {
-	users, err := data.FetchRepoUsers(ctx.RepoOwner, ctx.RepoName)
+	users, err := data.FetchRepoUsers(ctx.RepoOwner, ctx.RepoName, ctx.Host)
 	src.Users = users
 	src.Err = err
 
@@ -120,13 +120,13 @@ func (*SearchQuerySource) ItemsToExclude(input string, cursorPos tea.Position) [
 func (src *SearchQuerySource) LoadSuggestions(ctx LoaderContext) error {
 	var wg sync.WaitGroup
 	wg.Go(func() {
-		users, err := data.FetchRepoUsers(ctx.RepoOwner, ctx.RepoName)
+		users, err := data.FetchRepoUsers(ctx.RepoOwner, ctx.RepoName, ctx.Host)
 		src.Users = users
 		src.UsersErr = err
 	})
 
 	wg.Go(func() {
-		labels, err := data.FetchRepoLabels(fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName))
+		labels, err := data.FetchRepoLabels(fmt.Sprintf("%s/%s", ctx.RepoOwner, ctx.RepoName), ctx.Host)
 		src.Labels = labels
 		src.LabelsErr = err
 	})
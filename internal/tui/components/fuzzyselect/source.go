@@ -11,6 +11,7 @@ type Context struct {
 type LoaderContext struct {
 	RepoOwner string
 	RepoName  string
+	Host      string
 }
 
 // Sources can load suggestions, return them based on the cursor position and insert them.
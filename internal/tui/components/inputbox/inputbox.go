@@ -334,6 +334,13 @@ func (m *Model) SetPrompt(prompt string) {
 	m.prompt = prompt
 }
 
+// SetInputPrompt sets the prompt shown in front of a single line input
+func (m *Model) SetInputPrompt(prompt string) {
+	if m.textInput != nil {
+		m.textInput.Prompt = prompt
+	}
+}
+
 func (m *Model) Reset() {
 	if m.textArea != nil {
 		m.textArea.Reset()
@@ -0,0 +1,32 @@
+package issuessection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// groupIssues groups the issues by the section's groupBy key, if any
+func (m *Model) groupIssues() {
+	if m.Config.GroupBy == "" {
+		return
+	}
+	m.Table.SetGroups(section.GroupRows(m.Issues, func(issue data.IssueData) string {
+		return issueGroup(m.Config.GroupBy, issue)
+	}))
+}
+
+func issueGroup(groupBy config.GroupKey, issue data.IssueData) string {
+	switch groupBy {
+	case config.GroupByRepo:
+		return issue.Repository.NameWithOwner
+	case config.GroupByAuthor:
+		return issue.Author.Login
+	case config.GroupByLabel:
+		if labels := issue.Labels.Nodes; len(labels) > 0 {
+			return labels[0].Name
+		}
+		return "No labels"
+	}
+	return ""
+}
@@ -25,6 +25,8 @@ const SectionType = "issue"
 type Model struct {
 	section.BaseModel
 	Issues []data.IssueData
+	// batch fetches the first page along with the other sections' ones
+	batch *data.SearchBatch[data.IssuesResponse]
 }
 
 func NewModel(
@@ -59,6 +61,11 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
 
+		if m.IsQuickFilterFocused() {
+			cmd = m.UpdateQuickFilter(msg)
+			break
+		}
+
 		if m.IsSearchFocused() {
 			switch msg.String() {
 			case "ctrl+c", "esc":
@@ -87,14 +94,24 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			case "enter":
 				input := m.PromptConfirmationBox.Value()
 				action := m.GetPromptConfirmationAction()
-				if input == "Y" || input == "y" {
+				if action == "select" {
+					if m.SelectMatchingRows(input) == 0 {
+						m.Ctx.Error = fmt.Errorf(`no issues match "%s"`, input)
+					}
+				} else if action == "save_section" {
+					cmd = m.SaveSection(input)
+				} else if input == "Y" || input == "y" {
 					issue := m.GetCurrRow()
 					sid := tasks.SectionIdentifier{Id: m.Id, Type: SectionType}
-					switch action {
-					case "close":
-						cmd = tasks.CloseIssue(m.Ctx, sid, issue)
-					case "reopen":
-						cmd = tasks.ReopenIssue(m.Ctx, sid, issue)
+					if bulkCmd, ok := m.runBulkAction(sid, action); ok {
+						cmd = bulkCmd
+					} else {
+						switch action {
+						case "close":
+							cmd = tasks.CloseIssue(m.Ctx, sid, issue)
+						case "reopen":
+							cmd = tasks.ReopenIssue(m.Ctx, sid, issue)
+						}
 					}
 				}
 
@@ -147,11 +164,15 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				}
 				m.Issues[i] = currIssue
 				m.SetIsLoading(false)
+				m.MarkSelectedRows(m.rowUrls())
 				m.Table.SetRows(m.BuildRows())
 				break
 			}
 		}
 
+	case section.ClearSelectionMsg:
+		m.ClearSelection()
+
 	case SectionIssuesFetchedMsg:
 		if m.LastFetchTaskId == msg.TaskId {
 			if m.PageInfo != nil {
@@ -159,15 +180,20 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			} else {
 				m.Issues = msg.Issues
 			}
+			m.sortIssues()
+			m.groupIssues()
 			m.TotalCount = msg.TotalCount
 			m.SetIsLoading(false)
 			m.PageInfo = &msg.PageInfo
+			m.MarkSelectedRows(m.rowUrls())
 			m.Table.SetRows(m.BuildRows())
 			m.UpdateLastUpdated(time.Now())
 			m.UpdateTotalItemsCount(m.TotalCount)
 		}
 	}
 
+	m.ApplyQuickFilter(m.FilterableRows())
+
 	search, searchCmd := m.SearchBar.Update(msg)
 	m.SearchBar = search
 
@@ -288,6 +314,25 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &issue
 }
 
+// UnenrichedNeighbours returns the issues up to n rows above and below the
+// current row that aren't in the enrichment cache yet, the closest first
+func (m *Model) UnenrichedNeighbours(n int) []data.IssueData {
+	idx := m.Table.GetCurrItem()
+	issues := make([]data.IssueData, 0, 2*n)
+	for distance := 1; distance <= n; distance++ {
+		for _, i := range []int{idx + distance, idx - distance} {
+			if i < 0 || i >= len(m.Issues) {
+				continue
+			}
+			if _, ok := data.CachedIssue(m.Issues[i].Url, m.Issues[i].UpdatedAt); ok {
+				continue
+			}
+			issues = append(issues, m.Issues[i])
+		}
+	}
+	return issues
+}
+
 func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	if m == nil {
 		return nil
@@ -304,6 +349,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 		startCursor = m.PageInfo.StartCursor
 	}
 	taskId := fmt.Sprintf("fetching_issues_%d_%s", m.Id, startCursor)
+	isFirstFetch := m.LastFetchTaskId == ""
 	m.LastFetchTaskId = taskId
 	task := context.Task{
 		Id:        taskId,
@@ -318,12 +364,29 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	startCmd := m.Ctx.StartTask(task)
 	cmds = append(cmds, startCmd)
 
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.IssuesLimit
+	}
+	filters := m.GetFilters()
+	isFirstPage := m.PageInfo == nil
+
+	batch, batchIndex := m.batch, -1
+	m.batch = nil
+	if batch != nil && isFirstPage {
+		// A batch that's already being fetched leaves the index at -1, so the
+		// section is fetched on its own
+		batchIndex, _ = batch.Add(data.SearchRequest{Query: filters, Limit: *limit, Host: m.Config.Host})
+	}
+
 	fetchCmd := func() tea.Msg {
-		limit := m.Config.Limit
-		if limit == nil {
-			limit = &m.Ctx.Config.Defaults.IssuesLimit
+		var res data.IssuesResponse
+		var err error
+		if batchIndex != -1 {
+			res, err = batch.Result(batchIndex)
+		} else {
+			res, err = data.FetchIssues(filters, *limit, m.PageInfo, m.Config.Host)
 		}
-		res, err := data.FetchIssues(m.GetFilters(), *limit, m.PageInfo)
 		if err != nil {
 			return constants.TaskFinishedMsg{
 				SectionId:   m.Id,
@@ -332,6 +395,9 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 				Err:         err,
 			}
 		}
+		if isFirstPage {
+			data.SaveCachedIssues(filters, *limit, m.Config.Host, res)
+		}
 
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
@@ -347,9 +413,32 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	}
 	cmds = append(cmds, fetchCmd)
 
+	if isFirstFetch && !m.loadCachedRows(filters, *limit) {
+		m.SetIsLoading(true)
+		cmds = append(cmds, m.Table.StartLoadingSpinner())
+	}
+
 	return cmds
 }
 
+// loadCachedRows shows the issues cached by the last run while the section
+// refetches them. It returns false if nothing was cached.
+func (m *Model) loadCachedRows(filters string, limit int) bool {
+	res, fetchedAt, ok := data.LoadCachedIssues(filters, limit, m.Config.Host)
+	if !ok {
+		return false
+	}
+
+	m.Issues = res.Issues
+	m.sortIssues()
+	m.groupIssues()
+	m.TotalCount = res.TotalCount
+	m.Table.SetRows(m.BuildRows())
+	m.Table.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+	return true
+}
+
 func (m *Model) UpdateLastUpdated(t time.Time) {
 	m.Table.UpdateLastUpdated(t)
 }
@@ -365,6 +454,7 @@ func FetchAllSections(
 	sectionConfigs := ctx.Config.IssuesSections
 	fetchIssuesCmds := make([]tea.Cmd, 0, len(sectionConfigs))
 	sections = make([]section.Section, 0, len(sectionConfigs))
+	batch := data.NewIssuesBatch()
 	for i, sectionConfig := range sectionConfigs {
 		sectionModel := NewModel(
 			i+1,
@@ -376,6 +466,7 @@ func FetchAllSections(
 		if sectionConfig.Layout.CreatorIcon.Hidden != nil {
 			sectionModel.ShowAuthorIcon = !*sectionConfig.Layout.CreatorIcon.Hidden
 		}
+		sectionModel.batch = batch
 		sections = append(sections, &sectionModel)
 		fetchIssuesCmds = append(
 			fetchIssuesCmds,
@@ -453,6 +544,9 @@ func (m Model) GetPagerContent() string {
 			len(m.Table.Rows),
 		)
 	}
+	if n := m.NumSelected(); n > 0 {
+		pagerContent += fmt.Sprintf(" • %d selected", n)
+	}
 	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
 	return pager
 }
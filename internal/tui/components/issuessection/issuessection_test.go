@@ -0,0 +1,24 @@
+package issuessection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestUnenrichedNeighbours(t *testing.T) {
+	m := Model{Issues: []data.IssueData{
+		{Number: 1, Url: "https://github.com/owner/repo/issues/1"},
+		{Number: 2, Url: "https://github.com/owner/repo/issues/2"},
+		{Number: 3, Url: "https://github.com/owner/repo/issues/3"},
+	}}
+
+	var numbers []int
+	for _, issue := range m.UnenrichedNeighbours(2) {
+		numbers = append(numbers, issue.Number)
+	}
+	require.Equal(t, []int{2, 3}, numbers)
+	require.Empty(t, m.UnenrichedNeighbours(0))
+}
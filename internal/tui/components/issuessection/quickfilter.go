@@ -0,0 +1,22 @@
+package issuessection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// FilterableRows returns the fields the quick filter matches the issues on
+func (m *Model) FilterableRows() []section.FilterableRow {
+	rows := make([]section.FilterableRow, 0, len(m.Issues))
+	for _, issue := range m.Issues {
+		row := section.FilterableRow{
+			Title:  issue.Title,
+			Author: issue.Author.Login,
+			Repo:   issue.GetRepoNameWithOwner(),
+		}
+		for _, label := range issue.Labels.Nodes {
+			row.Labels = append(row.Labels, label.Name)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,118 @@
+package issuessection
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+func (m *Model) ToggleCurrRowSelection() {
+	issue := m.GetCurrRow()
+	if issue == nil {
+		return
+	}
+	m.ToggleSelected(issue.GetUrl())
+	m.syncSelection()
+}
+
+// SelectAllRows selects all the issues fetched so far, except those hidden
+// by the quick filter
+func (m *Model) SelectAllRows() {
+	m.SelectRows(m.shownRowUrls())
+	m.syncSelection()
+}
+
+// SelectMatchingRows selects the shown issues fetched so far that match the
+// filter and returns how many matched
+func (m *Model) SelectMatchingRows(filter string) int {
+	var urls []string
+	for i, issue := range m.Issues {
+		if !m.Table.IsRowShown(i) {
+			continue
+		}
+		row := section.SelectableRow{
+			Url:    issue.Url,
+			Number: issue.Number,
+			Title:  issue.Title,
+			Repo:   issue.GetRepoNameWithOwner(),
+			Author: issue.Author.Login,
+			State:  issue.State,
+		}
+		for _, label := range issue.Labels.Nodes {
+			row.Labels = append(row.Labels, label.Name)
+		}
+		if row.Matches(filter) {
+			urls = append(urls, row.Url)
+		}
+	}
+	m.SelectRows(urls)
+	m.syncSelection()
+	return len(urls)
+}
+
+func (m *Model) GetSelectedRows() []data.RowData {
+	issues := m.GetSelectedIssues()
+	rows := make([]data.RowData, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, issue)
+	}
+	return rows
+}
+
+// GetSelectedIssues returns the selected issues in the order they're shown
+func (m *Model) GetSelectedIssues() []data.IssueData {
+	var issues []data.IssueData
+	for _, issue := range m.Issues {
+		if m.IsSelected(issue.Url) {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// runBulkAction runs the confirmed action over the selected issues and clears
+// the selection. ok is false if nothing is selected or the action only runs on
+// the current issue.
+func (m *Model) runBulkAction(sid tasks.SectionIdentifier, action string) (cmd tea.Cmd, ok bool) {
+	issues := m.GetSelectedRows()
+	if len(issues) == 0 {
+		return nil, false
+	}
+
+	switch action {
+	case "close":
+		cmd = tasks.CloseIssues(m.Ctx, sid, issues)
+	case "reopen":
+		cmd = tasks.ReopenIssues(m.Ctx, sid, issues)
+	default:
+		return nil, false
+	}
+
+	m.ClearSelection()
+	return cmd, true
+}
+
+func (m *Model) rowUrls() []string {
+	urls := make([]string, 0, len(m.Issues))
+	for _, issue := range m.Issues {
+		urls = append(urls, issue.Url)
+	}
+	return urls
+}
+
+func (m *Model) shownRowUrls() []string {
+	var urls []string
+	for i, url := range m.rowUrls() {
+		if m.Table.IsRowShown(i) {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+func (m *Model) syncSelection() {
+	m.MarkSelectedRows(m.rowUrls())
+	m.Table.SyncViewPortContent()
+}
@@ -0,0 +1,37 @@
+package issuessection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// sortKeys are the keys issue sections cycle through
+var sortKeys = []config.SortKey{
+	config.SortByUpdated,
+	config.SortByCreated,
+	config.SortByComments,
+	config.SortByRepo,
+	config.SortByAuthor,
+}
+
+func (m *Model) CycleSortKey() config.SortKey {
+	m.SortKeys = section.NextSortKeys(m.SortKeys, m.Config.Sort, sortKeys)
+	m.sortIssues()
+	m.groupIssues()
+	m.Table.SetRows(m.BuildRows())
+	m.ApplyQuickFilter(m.FilterableRows())
+	return m.SortKeys[0]
+}
+
+func (m *Model) sortIssues() {
+	section.SortRows(m.Issues, m.SortKeys, func(issue data.IssueData) section.SortableRow {
+		return section.SortableRow{
+			UpdatedAt: issue.UpdatedAt,
+			CreatedAt: issue.CreatedAt,
+			Comments:  issue.Comments.TotalCount,
+			Repo:      issue.Repository.NameWithOwner,
+			Author:    issue.Author.Login,
+		}
+	})
+}
@@ -31,11 +31,12 @@ var (
 )
 
 type Model struct {
-	ctx       *context.ProgramContext
-	issue     *issuerow.Issue
-	sectionId int
-	width     int
-	editor    cmpcontroller.Controller
+	ctx            *context.ProgramContext
+	issue          *issuerow.Issue
+	sectionId      int
+	width          int
+	editor         cmpcontroller.Controller
+	selectedIssues []data.IssueData
 }
 
 func NewModel(ctx *context.ProgramContext) Model {
@@ -61,6 +62,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd, *IssueAction) {
 
 		sid := tasks.SectionIdentifier{Id: m.sectionId, Type: issuessection.SectionType}
 
+		if cmd, ok := m.submitSelection(sid, mode, value); ok {
+			return m, cmd, nil
+		}
+
 		switch mode {
 		case cmpcontroller.ModeComment:
 			if len(strings.TrimSpace(value)) != 0 {
@@ -146,6 +151,10 @@ func (m Model) View() string {
 	s.WriteString(m.renderActivity())
 
 	if m.editor.Mode() != cmpcontroller.ModeNone {
+		if note := m.renderSelectionNote(); note != "" {
+			s.WriteString("\n\n")
+			s.WriteString(note)
+		}
 		s.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
 	}
 
@@ -330,7 +339,7 @@ func (m *Model) SetIsAssigning(isAssigning bool) tea.Cmd {
 	}
 
 	initialValue := ""
-	if !m.userAssignedToIssue(m.ctx.User) {
+	if len(m.selectedIssues) > 0 || !m.userAssignedToIssue(m.ctx.User) {
 		initialValue = m.ctx.User
 	}
 
@@ -358,17 +367,25 @@ func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
 		return nil
 	}
 
-	labels := make([]string, 0, len(m.issue.Data.Labels.Nodes)+1)
-	for _, label := range m.issue.Data.Labels.Nodes {
-		labels = append(labels, label.Name)
+	// The selected issues have different labels so only adding labels to all
+	// of them is supported
+	prompt := constants.AddLabelsPrompt
+	initialValue := ""
+	if len(m.selectedIssues) == 0 {
+		labels := make([]string, 0, len(m.issue.Data.Labels.Nodes)+1)
+		for _, label := range m.issue.Data.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
+		labels = append(labels, "")
+		prompt = constants.LabelPrompt
+		initialValue = strings.Join(labels, ", ")
 	}
-	labels = append(labels, "")
 
 	m.editor.SetAutocompleteSource(&fuzzyselect.LabelSource{})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeLabel,
-		Prompt:                           constants.LabelPrompt,
-		InitialValue:                     strings.Join(labels, ", "),
+		Prompt:                           prompt,
+		InitialValue:                     initialValue,
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
 		HideAutocompleteWhenContextEmpty: false,
@@ -425,6 +442,7 @@ func (m *Model) repoRef() cmpcontroller.RepoRef {
 		NameWithOwner: m.issue.Data.GetRepoNameWithOwner(),
 		Owner:         owner,
 		Name:          repo,
+		Host:          data.HostOf(m.issue.Data.GetUrl()),
 	}
 }
 
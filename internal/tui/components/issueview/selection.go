@@ -0,0 +1,81 @@
+package issueview
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+// SetSelectedIssues sets the issues selected in the section. While there are
+// any, assigning and labeling run over all of them instead of the viewed
+// issue.
+func (m *Model) SetSelectedIssues(issues []data.IssueData) {
+	m.selectedIssues = issues
+}
+
+func (m *Model) isEditingSelection() bool {
+	if len(m.selectedIssues) == 0 {
+		return false
+	}
+
+	switch m.editor.Mode() {
+	case cmpcontroller.ModeAssign, cmpcontroller.ModeLabel:
+		return true
+	}
+	return false
+}
+
+// submitSelection runs the submitted action over the selected issues and
+// clears the selection of the section. ok is false if the action only runs on
+// the viewed issue.
+func (m *Model) submitSelection(
+	sid tasks.SectionIdentifier,
+	mode cmpcontroller.Mode,
+	value string,
+) (cmd tea.Cmd, ok bool) {
+	issues := m.selectedIssues
+	if len(issues) == 0 {
+		return nil, false
+	}
+
+	switch mode {
+	case cmpcontroller.ModeAssign:
+		usernames := fuzzyselect.AllWords(value)
+		if len(usernames) == 0 {
+			return nil, true
+		}
+		rows := make([]data.RowData, 0, len(issues))
+		for _, issue := range issues {
+			rows = append(rows, issue)
+		}
+		cmd = tasks.AssignIssues(m.ctx, sid, rows, usernames)
+	case cmpcontroller.ModeLabel:
+		labels := fuzzyselect.CurrentLabels(value)
+		if len(labels) == 0 {
+			return nil, true
+		}
+		cmd = tasks.AddIssueLabels(m.ctx, sid, issues, labels)
+	default:
+		return nil, false
+	}
+
+	m.selectedIssues = nil
+	return tea.Batch(cmd, func() tea.Msg {
+		return section.ClearSelectionMsg{SectionId: sid.Id, SectionType: sid.Type}
+	}), true
+}
+
+func (m *Model) renderSelectionNote() string {
+	if !m.isEditingSelection() {
+		return ""
+	}
+
+	return m.ctx.Styles.Common.FaintTextStyle.Render(
+		fmt.Sprintf("Applies to the %d selected issues", len(m.selectedIssues)))
+}
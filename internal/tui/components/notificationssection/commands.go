@@ -40,7 +40,7 @@ func (m *Model) markAsDone() tea.Cmd {
 	}
 	startCmd := m.Ctx.StartTask(task)
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := markNotificationDoneFunc(notificationId)
+		err := markNotificationDoneFunc(notificationId, m.Config.Host)
 		if err == nil {
 			// Persist to done store so it stays hidden across sessions
 			data.GetDoneStore().MarkDone(notificationId, updatedAt)
@@ -91,7 +91,7 @@ func (m *Model) markAllAsDone() tea.Cmd {
 		doneStore := data.GetDoneStore()
 		var lastErr error
 		for _, e := range entries {
-			if err := data.MarkNotificationDone(e.id); err != nil {
+			if err := data.MarkNotificationDone(e.id, m.Config.Host); err != nil {
 				lastErr = err
 			} else {
 				// Persist to done store so it stays hidden across sessions
@@ -130,7 +130,7 @@ func (m *Model) markAllAsRead() tea.Cmd {
 	}
 	startCmd := m.Ctx.StartTask(task)
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := data.MarkAllNotificationsRead()
+		err := data.MarkAllNotificationsRead(m.Config.Host)
 		if err != nil {
 			return constants.TaskFinishedMsg{
 				SectionId:   m.Id,
@@ -179,7 +179,7 @@ func (m *Model) markAsRead() tea.Cmd {
 	}
 	startCmd := m.Ctx.StartTask(task)
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := data.MarkNotificationRead(notificationId)
+		err := data.MarkNotificationRead(notificationId, m.Config.Host)
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: SectionType,
@@ -210,7 +210,7 @@ func (m *Model) unsubscribe() tea.Cmd {
 	}
 	startCmd := m.Ctx.StartTask(task)
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := data.UnsubscribeFromThread(notificationId)
+		err := data.UnsubscribeFromThread(notificationId, m.Config.Host)
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: SectionType,
@@ -246,7 +246,7 @@ func (m *Model) openInBrowser() tea.Cmd {
 
 	return tea.Batch(
 		func() tea.Msg {
-			_ = data.MarkNotificationRead(notificationId)
+			_ = data.MarkNotificationRead(notificationId, m.Config.Host)
 			return UpdateNotificationReadStateMsg{
 				Id:     notificationId,
 				Unread: false,
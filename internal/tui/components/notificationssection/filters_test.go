@@ -110,7 +110,7 @@ func TestParseNotificationFilters(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			filters := parseNotificationFilters(tt.search, false)
+			filters := ParseNotificationFilters(tt.search, false)
 
 			if filters.ReadState != tt.wantReadState {
 				t.Errorf("ReadState = %v, want %v", filters.ReadState, tt.wantReadState)
@@ -274,7 +274,7 @@ func TestParseNotificationFiltersWithReasons(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			filters := parseNotificationFilters(tt.search, false)
+			filters := ParseNotificationFilters(tt.search, false)
 			if len(filters.ReasonFilters) != tt.wantReasonCount {
 				t.Errorf(
 					"ReasonFilters count = %d, want %d",
@@ -443,7 +443,7 @@ func TestParseNotificationFiltersEdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			filters := parseNotificationFilters(tt.search, false)
+			filters := ParseNotificationFilters(tt.search, false)
 
 			if filters.ReadState != tt.wantReadState {
 				t.Errorf("ReadState = %v, want %v", filters.ReadState, tt.wantReadState)
@@ -588,7 +588,7 @@ func TestParseNotificationFiltersIncludeRead(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			filters := parseNotificationFilters(tt.search, tt.includeRead)
+			filters := ParseNotificationFilters(tt.search, tt.includeRead)
 
 			if filters.ReadState != tt.wantReadState {
 				t.Errorf("ReadState = %v, want %v", filters.ReadState, tt.wantReadState)
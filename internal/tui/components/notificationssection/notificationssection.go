@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -96,10 +97,10 @@ func parseReasonFilters(search string) []string {
 	return reasons
 }
 
-// parseNotificationFilters extracts all notification filters from search string.
+// ParseNotificationFilters extracts all notification filters from search string.
 // When includeRead is true (the default config), the default read state is "all"
 // instead of "unread", matching GitHub's default behavior.
-func parseNotificationFilters(search string, includeRead bool) NotificationFilters {
+func ParseNotificationFilters(search string, includeRead bool) NotificationFilters {
 	defaultReadState := data.NotificationStateUnread
 	if includeRead {
 		defaultReadState = data.NotificationStateAll
@@ -196,7 +197,7 @@ func NewModel(
 	// Set 3-line content height for notification rows
 	m.Table.SetContentHeight(3)
 	// Respect smartFilteringAtLaunch - scope to current repo by default if enabled
-	m.IsFilteredByCurrentRemote = ctx.Config.SmartFilteringAtLaunch
+	m.IsFilteredByCurrentRemote = ctx.Config.SmartFilteringAtLaunch && ctx.RepoScope == ""
 	m.SearchValue = m.GetSearchValue()
 	m.SearchBar.SetValue(m.SearchValue)
 	m.Notifications = []notificationrow.Data{}
@@ -211,6 +212,11 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.IsQuickFilterFocused() {
+			cmd = m.UpdateQuickFilter(msg)
+			break
+		}
+
 		if m.IsSearchFocused() {
 			switch msg.String() {
 			case "ctrl+c", "esc":
@@ -239,7 +245,9 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			case "enter":
 				input := m.PromptConfirmationBox.Value()
 				action := m.GetPromptConfirmationAction()
-				if input == "Y" || input == "y" {
+				if action == "save_section" {
+					cmd = m.SaveSection(input)
+				} else if input == "Y" || input == "y" {
 					switch action {
 					case "done":
 						cmd = m.markAsDone()
@@ -296,6 +304,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 		case key.Matches(msg, keys.NotificationKeys.SortByRepo):
 			m.toggleSortOrder()
+			m.groupNotifications()
 			m.Table.SetRows(m.BuildRows())
 			return m, nil
 
@@ -327,6 +336,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			delete(m.sessionMarkedRead, msg.Id)
 			m.TotalCount = len(m.Notifications)
 			m.SetIsLoading(false)
+			m.groupNotifications()
 			m.Table.SetRows(m.BuildRows())
 			m.UpdateTotalItemsCount(m.TotalCount)
 			// If the removed item was the last one, move the current row to the new last item.
@@ -396,6 +406,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			m.TotalCount = len(m.Notifications)
 			m.PageInfo = &msg.PageInfo
 			m.SetIsLoading(false)
+			m.groupNotifications()
 			m.Table.SetRows(m.BuildRows())
 			m.UpdateLastUpdated(time.Now())
 			m.UpdateTotalItemsCount(m.TotalCount)
@@ -426,6 +437,8 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 		m.Table.SetRows(m.BuildRows())
 	}
 
+	m.ApplyQuickFilter(m.FilterableRows())
+
 	search, searchCmd := m.SearchBar.Update(msg)
 	m.SearchBar = search
 
@@ -505,6 +518,24 @@ func (m *Model) sortNotifications() {
 	}
 }
 
+// groupNotifications groups the notifications by the section's groupBy key,
+// if any
+func (m *Model) groupNotifications() {
+	if m.Config.GroupBy == "" {
+		return
+	}
+	m.Table.SetGroups(section.GroupRows(m.Notifications, func(n notificationrow.Data) string {
+		if m.Config.GroupBy == config.GroupByReason {
+			reason := strings.ReplaceAll(n.GetReason(), "_", " ")
+			if reason == "" {
+				return "Other"
+			}
+			return strings.ToUpper(reason[:1]) + reason[1:]
+		}
+		return n.Notification.Repository.FullName
+	}))
+}
+
 func (m Model) BuildRows() []table.Row {
 	var rows []table.Row
 	for i := range m.Notifications {
@@ -553,7 +584,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	var cmds []tea.Cmd
 
 	// Parse filters from search value (includes repo filter if smartFilteringAtLaunch is enabled)
-	filters := parseNotificationFilters(m.GetSearchValue(), m.Ctx.Config.IncludeReadNotifications)
+	filters := ParseNotificationFilters(m.GetSearchValue(), m.Ctx.Config.IncludeReadNotifications)
 
 	// Handle is:done filter - these notifications cannot be retrieved
 	if filters.IsDone {
@@ -634,6 +665,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 				filters.RepoFilters,
 				readState,
 				currentPageInfo,
+				m.Config.Host,
 			)
 			if err != nil {
 				return constants.TaskFinishedMsg{
@@ -694,7 +726,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 						wg.Add(1)
 						go func(threadId string) {
 							defer wg.Done()
-							notification, err := data.FetchNotificationByThreadId(threadId)
+							notification, err := data.FetchNotificationByThreadId(threadId, m.Config.Host)
 							results <- fetchResult{notification: notification, err: err}
 						}(id)
 					}
@@ -837,6 +869,8 @@ func FetchAllSections(
 				sectionModel.LastFetchTaskId = oldSection.LastFetchTaskId
 				sectionModel.sessionMarkedRead = oldSection.sessionMarkedRead
 				sectionModel.sessionMarkedDone = oldSection.sessionMarkedDone
+				sectionModel.Table.SetCollapsedGroups(oldSection.Table.CollapsedGroups())
+				sectionModel.groupNotifications()
 				// Preserve user's filter state - don't reset on refresh
 				sectionModel.IsFilteredByCurrentRemote = oldSection.IsFilteredByCurrentRemote
 				sectionModel.SearchValue = oldSection.SearchValue
@@ -1041,7 +1075,7 @@ func (m *Model) fetchCommentCountsForNotifications(notifications []notificationr
 			title := notif.Notification.Subject.Title
 			cmds = append(cmds, func() tea.Msg {
 				log.Debug("Fetching workflow run for CheckSuite", "id", id, "repo", repo)
-				url, err := data.FetchRecentWorkflowRun(repo, updatedAt, title)
+				url, err := data.FetchRecentWorkflowRun(repo, updatedAt, title, m.Config.Host)
 				if err != nil {
 					log.Error("Failed to fetch workflow run", "id", id, "err", err)
 					return nil
@@ -0,0 +1,19 @@
+package notificationssection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// FilterableRows returns the fields the quick filter matches the
+// notifications on, the actor who triggered them being their author
+func (m *Model) FilterableRows() []section.FilterableRow {
+	rows := make([]section.FilterableRow, 0, len(m.Notifications))
+	for _, notification := range m.Notifications {
+		rows = append(rows, section.FilterableRow{
+			Title:  notification.GetTitle(),
+			Author: notification.Actor,
+			Repo:   notification.GetRepoNameWithOwner(),
+		})
+	}
+	return rows
+}
@@ -20,9 +20,10 @@ type Model struct {
 	width int
 
 	// Cached notification subject data for sidebar display
-	subjectPR    *prrow.Data
-	subjectIssue *data.IssueData
-	subjectId    string // ID of the notification whose subject is cached
+	subjectPR         *prrow.Data
+	subjectIssue      *data.IssueData
+	subjectDiscussion *data.DiscussionData
+	subjectId         string // ID of the notification whose subject is cached
 
 	// Pending confirmation action for PR/Issue (e.g., "pr_close", "issue_reopen")
 	pendingAction string
@@ -45,18 +46,28 @@ func (m *Model) SetWidth(width int) {
 func (m *Model) ResetSubject() {
 	m.subjectPR = nil
 	m.subjectIssue = nil
+	m.subjectDiscussion = nil
 	m.subjectId = ""
 }
 
 func (m *Model) SetSubjectPR(pr *prrow.Data, notificationId string) {
 	m.subjectPR = pr
 	m.subjectIssue = nil
+	m.subjectDiscussion = nil
 	m.subjectId = notificationId
 }
 
 func (m *Model) SetSubjectIssue(issue *data.IssueData, notificationId string) {
 	m.subjectIssue = issue
 	m.subjectPR = nil
+	m.subjectDiscussion = nil
+	m.subjectId = notificationId
+}
+
+func (m *Model) SetSubjectDiscussion(discussion *data.DiscussionData, notificationId string) {
+	m.subjectDiscussion = discussion
+	m.subjectPR = nil
+	m.subjectIssue = nil
 	m.subjectId = notificationId
 }
 
@@ -68,6 +79,10 @@ func (m *Model) GetSubjectIssue() *data.IssueData {
 	return m.subjectIssue
 }
 
+func (m *Model) GetSubjectDiscussion() *data.DiscussionData {
+	return m.subjectDiscussion
+}
+
 func (m *Model) GetSubjectId() string {
 	return m.subjectId
 }
@@ -75,6 +90,7 @@ func (m *Model) GetSubjectId() string {
 func (m *Model) ClearSubject() {
 	m.subjectPR = nil
 	m.subjectIssue = nil
+	m.subjectDiscussion = nil
 	m.subjectId = ""
 }
 
@@ -0,0 +1,44 @@
+package prssection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// groupPrs groups the PRs by the section's groupBy key, if any
+func (m *Model) groupPrs() {
+	if m.Config.GroupBy == "" {
+		return
+	}
+	m.Table.SetGroups(section.GroupRows(m.Prs, func(pr prrow.Data) string {
+		return prGroup(m.Config.GroupBy, pr)
+	}))
+}
+
+func prGroup(groupBy config.GroupKey, pr prrow.Data) string {
+	switch groupBy {
+	case config.GroupByRepo:
+		return pr.Primary.Repository.NameWithOwner
+	case config.GroupByAuthor:
+		return pr.Primary.Author.Login
+	case config.GroupByLabel:
+		if labels := pr.Primary.Labels.Nodes; len(labels) > 0 {
+			return labels[0].Name
+		}
+		return "No labels"
+	case config.GroupByBaseBranch:
+		return pr.Primary.BaseRefName
+	case config.GroupByReviewStatus:
+		switch pr.Primary.ReviewDecision {
+		case "APPROVED":
+			return "Approved"
+		case "CHANGES_REQUESTED":
+			return "Changes requested"
+		case "REVIEW_REQUIRED":
+			return "Review required"
+		}
+		return "No review required"
+	}
+	return ""
+}
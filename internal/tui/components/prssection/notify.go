@@ -0,0 +1,213 @@
+package prssection
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+	"github.com/charmbracelet/x/ansi"
+	checks "github.com/dlvhdr/x/gh-checks"
+	"github.com/gen2brain/beeep"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+)
+
+// prChange is a change of a PR between two fetches of the section
+type prChange struct {
+	event config.NotifyEvent
+	pr    data.PullRequestData
+}
+
+func (c prChange) String() string {
+	switch c.event {
+	case config.NotifyEventNewPr:
+		return fmt.Sprintf("New PR #%d %s", c.pr.Number, c.pr.Title)
+	case config.NotifyEventReviewRequested:
+		return fmt.Sprintf("Review requested on #%d %s", c.pr.Number, c.pr.Title)
+	case config.NotifyEventChecksFailed:
+		return fmt.Sprintf("Checks failed on #%d %s", c.pr.Number, c.pr.Title)
+	case config.NotifyEventMergeable:
+		return fmt.Sprintf("#%d %s is ready to merge", c.pr.Number, c.pr.Title)
+	}
+	return fmt.Sprintf("#%d %s", c.pr.Number, c.pr.Title)
+}
+
+// fetchedPrs are the PRs of a fetch of the first page of the section, along
+// with who their reviews are requested from, by the url of the PR. The
+// reviewers are only fetched when the section notifies about review requests.
+type fetchedPrs struct {
+	prs       []data.PullRequestData
+	reviewers map[string]data.ReviewRequests
+}
+
+// isViewerFunc tells if the review of pr was requested from the viewer,
+// directly or through one of their teams
+type isViewerFunc func(pr data.PullRequestData, reviewer data.ReviewRequestNode) bool
+
+// diffPrs returns the changes between the previous and current fetches of the
+// section, keeping only the events the section notifies about
+func diffPrs(
+	prev, curr fetchedPrs,
+	events []config.NotifyEvent,
+	isViewer isViewerFunc,
+) []prChange {
+	prevByUrl := make(map[string]data.PullRequestData, len(prev.prs))
+	for _, pr := range prev.prs {
+		prevByUrl[pr.Url] = pr
+	}
+
+	var changes []prChange
+	add := func(event config.NotifyEvent, pr data.PullRequestData) {
+		if slices.Contains(events, event) {
+			changes = append(changes, prChange{event: event, pr: pr})
+		}
+	}
+
+	for _, pr := range curr.prs {
+		prevPr, ok := prevByUrl[pr.Url]
+		if !ok {
+			add(config.NotifyEventNewPr, pr)
+			continue
+		}
+
+		// The reviewers of either fetch are missing when fetching them failed
+		prevReviewers, ok := prev.reviewers[pr.Url]
+		if ok && slices.ContainsFunc(
+			addedReviewers(prevReviewers, curr.reviewers[pr.Url]),
+			func(r data.ReviewRequestNode) bool {
+				return isViewer(pr, r)
+			},
+		) {
+			add(config.NotifyEventReviewRequested, pr)
+		}
+		if isFailing(pr) && !isFailing(prevPr) {
+			add(config.NotifyEventChecksFailed, pr)
+		}
+		if pr.MergeStateStatus == "CLEAN" && prevPr.MergeStateStatus != "CLEAN" {
+			add(config.NotifyEventMergeable, pr)
+		}
+	}
+
+	return changes
+}
+
+// addedReviewers returns the reviewers requested on curr that weren't on prev
+func addedReviewers(prev, curr data.ReviewRequests) []data.ReviewRequestNode {
+	var added []data.ReviewRequestNode
+	for _, r := range curr.Nodes {
+		if !slices.ContainsFunc(prev.Nodes, func(p data.ReviewRequestNode) bool {
+			return p.RequestedReviewer == r.RequestedReviewer
+		}) {
+			added = append(added, r)
+		}
+	}
+	return added
+}
+
+// viewerMatcher matches the reviewers that are the viewer or one of their
+// teams. The teams are fetched when a team is requested.
+func viewerMatcher(login string, host string) isViewerFunc {
+	return func(pr data.PullRequestData, reviewer data.ReviewRequestNode) bool {
+		if login == "" {
+			return false
+		}
+		if reviewer.RequestedReviewer.User.Login != "" {
+			return reviewer.RequestedReviewer.User.Login == login
+		}
+		slug := reviewer.RequestedReviewer.Team.Slug
+		if slug == "" {
+			return false
+		}
+		teams, err := data.FetchViewerTeams(pr.Repository.Owner.Login, login, host)
+		if err != nil {
+			log.Error("Failed fetching the viewer's teams", "err", err)
+			return false
+		}
+		return slices.Contains(teams, slug)
+	}
+}
+
+func isFailing(pr data.PullRequestData) bool {
+	row := prrow.PullRequest{Data: &prrow.Data{Primary: &pr}}
+	switch row.GetStatusChecksRollup() {
+	case checks.CommitStateFailure, checks.CommitStateError:
+		return true
+	}
+	return false
+}
+
+// notifyChanges raises a single notification listing the changes of the
+// section, using the method set in defaults.notifyMethod
+func (m *Model) notifyChanges(changes []prChange) tea.Cmd {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	title := fmt.Sprintf("gh-dash: %s", m.Config.Title)
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
+
+	switch m.Ctx.Config.Defaults.NotifyMethod {
+	case config.NotifyMethodBell:
+		return tea.Raw(string(rune(ansi.BEL)))
+	case config.NotifyMethodOsc9:
+		return tea.Raw(ansi.Notify(oscPayload(title + ": " + strings.Join(lines, "; "))))
+	case config.NotifyMethodOsc777:
+		return tea.Raw(fmt.Sprintf("\x1b]777;notify;%s;%s\x07",
+			oscPayload(title), oscPayload(strings.Join(lines, "; "))))
+	}
+
+	return func() tea.Msg {
+		if err := beeep.Notify(title, strings.Join(lines, "\n"), ""); err != nil {
+			log.Error("Error showing system notification", "err", err)
+		}
+		return nil
+	}
+}
+
+// oscPayload drops the characters that would end an OSC sequence early
+func oscPayload(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == ';' {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// onFirstPageFetched notifies about the changes since the previous fetch and
+// keeps the fetched PRs to compare the next fetch to
+func (m *Model) onFirstPageFetched(
+	prs []prrow.Data,
+	reviewers map[string]data.ReviewRequests,
+) tea.Cmd {
+	fetched := fetchedPrs{
+		prs:       make([]data.PullRequestData, 0, len(prs)),
+		reviewers: reviewers,
+	}
+	for _, pr := range prs {
+		fetched.prs = append(fetched.prs, *pr.Primary)
+	}
+
+	prev := m.fetchedPrs
+	m.fetchedPrs = fetched
+	if prev.prs == nil || len(m.notify) == 0 {
+		return nil
+	}
+
+	// Matching the viewer's teams may need a request, so the PRs are
+	// compared in the background
+	events, isViewer := m.notify, viewerMatcher(m.Ctx.User, m.Config.Host)
+	return func() tea.Msg {
+		if cmd := m.notifyChanges(diffPrs(prev, fetched, events, isViewer)); cmd != nil {
+			return cmd()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,144 @@
+package prssection
+
+import (
+	"fmt"
+	"testing"
+
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+)
+
+var allNotifyEvents = []config.NotifyEvent{
+	config.NotifyEventNewPr,
+	config.NotifyEventReviewRequested,
+	config.NotifyEventChecksFailed,
+	config.NotifyEventMergeable,
+}
+
+func prWithChecks(number int, state string) data.PullRequestData {
+	pr := data.PullRequestData{
+		Number: number,
+		Url:    fmt.Sprintf("https://github.com/owner/repo/pull/%d", number),
+	}
+	pr.Commits.Nodes = make([]struct {
+		Commit struct {
+			StatusCheckRollup struct {
+				State graphql.String
+			}
+		}
+	}, 1)
+	pr.Commits.Nodes[0].Commit.StatusCheckRollup.State = graphql.String(state)
+	return pr
+}
+
+// isMe matches the reviewer "me" and the team "core"
+func isMe(_ data.PullRequestData, reviewer data.ReviewRequestNode) bool {
+	return reviewer.RequestedReviewer.User.Login == "me" ||
+		reviewer.RequestedReviewer.Team.Slug == "core"
+}
+
+func requestedFrom(login string, team string) data.ReviewRequestNode {
+	var node data.ReviewRequestNode
+	node.RequestedReviewer.User.Login = login
+	node.RequestedReviewer.Team.Slug = team
+	return node
+}
+
+func reviewRequests(nodes ...data.ReviewRequestNode) data.ReviewRequests {
+	return data.ReviewRequests{TotalCount: len(nodes), Nodes: nodes}
+}
+
+func TestDiffPrs(t *testing.T) {
+	prev := []data.PullRequestData{
+		prWithChecks(1, "PENDING"),
+		prWithChecks(2, "SUCCESS"),
+		prWithChecks(3, "FAILURE"),
+	}
+
+	curr := []data.PullRequestData{
+		prWithChecks(1, "FAILURE"),
+		prWithChecks(2, "SUCCESS"),
+		prWithChecks(3, "FAILURE"),
+		prWithChecks(4, "PENDING"),
+	}
+	curr[1].MergeStateStatus = "CLEAN"
+	// new PRs are only notified about once
+	curr[3].MergeStateStatus = "CLEAN"
+
+	changes := diffPrs(
+		fetchedPrs{prs: prev, reviewers: map[string]data.ReviewRequests{curr[1].Url: {}}},
+		fetchedPrs{prs: curr, reviewers: map[string]data.ReviewRequests{
+			curr[1].Url: reviewRequests(requestedFrom("me", "")),
+		}},
+		allNotifyEvents,
+		isMe,
+	)
+
+	require.Equal(t, []prChange{
+		{event: config.NotifyEventChecksFailed, pr: curr[0]},
+		{event: config.NotifyEventReviewRequested, pr: curr[1]},
+		{event: config.NotifyEventMergeable, pr: curr[1]},
+		{event: config.NotifyEventNewPr, pr: curr[3]},
+	}, changes)
+}
+
+func TestDiffPrs_OnlyConfiguredEvents(t *testing.T) {
+	prev := []data.PullRequestData{prWithChecks(1, "PENDING")}
+	curr := []data.PullRequestData{prWithChecks(1, "FAILURE"), prWithChecks(2, "PENDING")}
+
+	changes := diffPrs(
+		fetchedPrs{prs: prev},
+		fetchedPrs{prs: curr},
+		[]config.NotifyEvent{config.NotifyEventNewPr},
+		isMe,
+	)
+
+	require.Len(t, changes, 1)
+	require.Equal(t, config.NotifyEventNewPr, changes[0].event)
+	require.Equal(t, "New PR #2 ", changes[0].String())
+}
+
+func TestDiffPrs_ReviewRequestedFromViewer(t *testing.T) {
+	prs := []data.PullRequestData{
+		prWithChecks(1, "SUCCESS"),
+		prWithChecks(2, "SUCCESS"),
+		prWithChecks(3, "SUCCESS"),
+		prWithChecks(4, "SUCCESS"),
+	}
+	prev := fetchedPrs{prs: prs, reviewers: map[string]data.ReviewRequests{
+		prs[0].Url: {},
+		prs[1].Url: {},
+		prs[2].Url: reviewRequests(requestedFrom("me", "")),
+	}}
+	curr := fetchedPrs{prs: prs, reviewers: map[string]data.ReviewRequests{
+		// Someone else was requested
+		prs[0].Url: reviewRequests(requestedFrom("alice", "")),
+		// One of the viewer's teams was requested
+		prs[1].Url: reviewRequests(requestedFrom("", "core")),
+		// The viewer was already requested before someone else was
+		prs[2].Url: reviewRequests(requestedFrom("me", ""), requestedFrom("alice", "")),
+		// The reviewers of the previous fetch are unknown
+		prs[3].Url: reviewRequests(requestedFrom("me", "")),
+	}}
+
+	changes := diffPrs(prev, curr, allNotifyEvents, isMe)
+
+	require.Equal(t, []prChange{
+		{event: config.NotifyEventReviewRequested, pr: prs[1]},
+	}, changes)
+}
+
+func TestOnFirstPageFetched_DoesNotNotifyOnFirstFetch(t *testing.T) {
+	m := newTestModel("")
+	m.notify = allNotifyEvents
+	pr := prWithChecks(1, "PENDING")
+
+	cmd := m.onFirstPageFetched([]prrow.Data{{Primary: &pr}}, nil)
+
+	require.Nil(t, cmd)
+	require.Equal(t, []data.PullRequestData{pr}, m.fetchedPrs.prs)
+}
@@ -26,6 +26,14 @@ const SectionType = "pr"
 type Model struct {
 	section.BaseModel
 	Prs []prrow.Data
+	// notify lists the changes between fetches the section notifies about
+	notify []config.NotifyEvent
+	// fetchedPrs are the PRs of the last fetch of the first page, which the
+	// next fetch is compared to. Its PRs are nil until the section was fetched.
+	fetchedPrs fetchedPrs
+	// batch is set by FetchAllSections so the first page is fetched along
+	// with the first pages of the other sections
+	batch *data.SearchBatch[data.PullRequestsResponse]
 }
 
 func NewModel(
@@ -50,6 +58,7 @@ func NewModel(
 		},
 	)
 	m.Prs = []prrow.Data{}
+	m.notify = cfg.Notify
 
 	return m
 }
@@ -61,6 +70,11 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 
+		if m.IsQuickFilterFocused() {
+			cmd = m.UpdateQuickFilter(msg)
+			break
+		}
+
 		if m.IsSearchFocused() {
 			switch msg.String() {
 			case "ctrl+c", "esc":
@@ -73,6 +87,7 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				m.SyncSmartFilterWithSearchValue()
 				m.SetIsSearching(false)
 				m.ResetRows()
+				m.fetchedPrs = fetchedPrs{}
 				return m, tea.Batch(m.FetchNextPageSectionRows()...)
 			}
 
@@ -91,20 +106,30 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				action := m.GetPromptConfirmationAction()
 				pr := m.GetCurrRow()
 				sid := tasks.SectionIdentifier{Id: m.Id, Type: SectionType}
-				if input == "Y" || input == "y" {
-					switch action {
-					case "close":
-						cmd = tasks.ClosePR(m.Ctx, sid, pr)
-					case "reopen":
-						cmd = tasks.ReopenPR(m.Ctx, sid, pr)
-					case "ready":
-						cmd = tasks.PRReady(m.Ctx, sid, pr)
-					case "merge":
-						cmd = tasks.MergePR(m.Ctx, sid, pr)
-					case "update":
-						cmd = tasks.UpdatePR(m.Ctx, sid, pr)
-					case "approveWorkflows":
-						cmd = tasks.ApproveWorkflows(m.Ctx, sid, pr)
+				if action == "select" {
+					if m.SelectMatchingRows(input) == 0 {
+						m.Ctx.Error = fmt.Errorf(`no PRs match "%s"`, input)
+					}
+				} else if action == "save_section" {
+					cmd = m.SaveSection(input)
+				} else if input == "Y" || input == "y" {
+					if bulkCmd, ok := m.runBulkAction(sid, action); ok {
+						cmd = bulkCmd
+					} else {
+						switch action {
+						case "close":
+							cmd = tasks.ClosePR(m.Ctx, sid, pr)
+						case "reopen":
+							cmd = tasks.ReopenPR(m.Ctx, sid, pr)
+						case "ready":
+							cmd = tasks.PRReady(m.Ctx, sid, pr)
+						case "merge":
+							cmd = tasks.MergePR(m.Ctx, sid, pr, data.MergeOptions{})
+						case "update":
+							cmd = tasks.UpdatePR(m.Ctx, sid, pr)
+						case "approveWorkflows":
+							cmd = tasks.ApproveWorkflows(m.Ctx, sid, pr)
+						}
 					}
 				}
 
@@ -172,6 +197,29 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				currPr.Enriched.Comments.Nodes = append(
 					currPr.Enriched.Comments.Nodes, *msg.NewComment)
 			}
+			if msg.NewReviewThread != nil {
+				currPr.Enriched.ReviewThreads.Nodes = append(
+					currPr.Enriched.ReviewThreads.Nodes, *msg.NewReviewThread)
+				currPr.Primary.ReviewThreads.TotalCount++
+			}
+			if msg.UpdatedThread != nil {
+				threads := currPr.Enriched.ReviewThreads.Nodes
+				for j := range threads {
+					if threads[j].Id == msg.UpdatedThread.Id {
+						threads[j] = *msg.UpdatedThread
+					}
+				}
+			}
+			if msg.NewThreadReply != nil {
+				threads := currPr.Enriched.ReviewThreads.Nodes
+				for j := range threads {
+					if threads[j].Id == msg.NewThreadReply.ThreadId {
+						threads[j].Comments.Nodes = append(
+							threads[j].Comments.Nodes, msg.NewThreadReply.Comment)
+						threads[j].Comments.TotalCount++
+					}
+				}
+			}
 			if msg.AddedAssignees != nil {
 				currPr.Primary.Assignees.Nodes = addAssignees(
 					currPr.Primary.Assignees.Nodes, msg.AddedAssignees.Nodes)
@@ -190,19 +238,28 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				currPr.Primary.State = "MERGED"
 				currPr.Primary.Mergeable = ""
 			}
+			if msg.IsInMergeQueue != nil {
+				currPr.Primary.IsInMergeQueue = *msg.IsInMergeQueue
+			}
 			m.Prs[i] = currPr
 			m.SetIsLoading(false)
 			m.Table.SetRows(m.BuildRows())
 			break
 		}
 
+	case section.ClearSelectionMsg:
+		m.ClearSelection()
+
 	case SectionPullRequestsFetchedMsg:
 		if m.LastFetchTaskId == msg.TaskId {
 			if m.PageInfo != nil {
 				m.Prs = append(m.Prs, msg.Prs...)
 			} else {
 				m.Prs = msg.Prs
+				cmd = m.onFirstPageFetched(msg.Prs, msg.RequestedReviewers)
 			}
+			m.sortPrs()
+			m.groupPrs()
 			m.TotalCount = msg.TotalCount
 			m.PageInfo = &msg.PageInfo
 			m.SetIsLoading(false)
@@ -213,7 +270,9 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	}
 
 	search, searchCmd := m.SearchBar.Update(msg)
+	m.MarkSelectedRows(m.rowUrls())
 	m.Table.SetRows(m.BuildRows())
+	m.ApplyQuickFilter(m.FilterableRows())
 	m.SearchBar = search
 
 	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
@@ -434,6 +493,10 @@ type SectionPullRequestsFetchedMsg struct {
 	TotalCount int
 	PageInfo   data.PageInfo
 	TaskId     string
+	// RequestedReviewers are who the reviews of the PRs are requested from,
+	// by the url of the PR. They're only fetched with the first page of the
+	// sections that notify about review requests.
+	RequestedReviewers map[string]data.ReviewRequests
 }
 
 func (m *Model) GetCurrRow() data.RowData {
@@ -445,6 +508,22 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &pr
 }
 
+// UnenrichedNeighbours returns the PRs up to n rows above and below the
+// current row that aren't enriched yet, the closest first
+func (m *Model) UnenrichedNeighbours(n int) []data.PullRequestData {
+	idx := m.Table.GetCurrItem()
+	prs := make([]data.PullRequestData, 0, 2*n)
+	for distance := 1; distance <= n; distance++ {
+		for _, i := range []int{idx + distance, idx - distance} {
+			if i < 0 || i >= len(m.Prs) || m.Prs[i].IsEnriched || m.Prs[i].Primary == nil {
+				continue
+			}
+			prs = append(prs, *m.Prs[i].Primary)
+		}
+	}
+	return prs
+}
+
 func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	if m == nil {
 		return nil
@@ -476,13 +555,30 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	startCmd := m.Ctx.StartTask(task)
 	cmds = append(cmds, startCmd)
 
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.PrsLimit
+	}
+	filters := m.GetFilters()
+	isFirstPage := m.PageInfo == nil
+
+	batch, batchIndex := m.batch, -1
+	m.batch = nil
+	if batch != nil && isFirstPage {
+		// A batch that's already being fetched leaves the index at -1, so the
+		// section is fetched on its own
+		batchIndex, _ = batch.Add(data.SearchRequest{Query: filters, Limit: *limit, Host: m.Config.Host})
+	}
+	fetchReviewers := isFirstPage && slices.Contains(m.notify, config.NotifyEventReviewRequested)
+
 	fetchCmd := func() tea.Msg {
-		limit := m.Config.Limit
-		if limit == nil {
-			limit = &m.Ctx.Config.Defaults.PrsLimit
+		var res data.PullRequestsResponse
+		var err error
+		if batchIndex != -1 {
+			res, err = batch.Result(batchIndex)
+		} else {
+			res, err = data.FetchPullRequests(filters, *limit, m.PageInfo, m.Config.Host)
 		}
-
-		res, err := data.FetchPullRequests(m.GetFilters(), *limit, m.PageInfo)
 		if err != nil {
 			return constants.TaskFinishedMsg{
 				SectionId:   m.Id,
@@ -491,6 +587,16 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 				Err:         err,
 			}
 		}
+		if isFirstPage {
+			data.SaveCachedPullRequests(filters, *limit, m.Config.Host, res)
+		}
+		var reviewers map[string]data.ReviewRequests
+		if fetchReviewers {
+			reviewers, err = data.FetchRequestedReviewers(filters, *limit, m.Config.Host)
+			if err != nil {
+				log.Error("Failed fetching the requested reviewers", "err", err)
+			}
+		}
 
 		prs := make([]prrow.Data, 0)
 		for _, pr := range res.Prs {
@@ -501,17 +607,18 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 			SectionType: m.Type,
 			TaskId:      taskId,
 			Msg: SectionPullRequestsFetchedMsg{
-				Prs:        prs,
-				TotalCount: res.TotalCount,
-				PageInfo:   res.PageInfo,
-				TaskId:     taskId,
+				Prs:                prs,
+				TotalCount:         res.TotalCount,
+				PageInfo:           res.PageInfo,
+				TaskId:             taskId,
+				RequestedReviewers: reviewers,
 			},
 		}
 	}
 	cmds = append(cmds, fetchCmd)
 
 	m.IsLoading = true
-	if isFirstFetch {
+	if isFirstFetch && !m.loadCachedRows(filters, *limit) {
 		m.SetIsLoading(true)
 		cmds = append(cmds, m.Table.StartLoadingSpinner())
 	}
@@ -519,6 +626,28 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	return cmds
 }
 
+// loadCachedRows shows the PRs cached by the last run while the section
+// refetches them. It returns false if nothing was cached.
+func (m *Model) loadCachedRows(filters string, limit int) bool {
+	res, fetchedAt, ok := data.LoadCachedPullRequests(filters, limit, m.Config.Host)
+	if !ok {
+		return false
+	}
+
+	prs := make([]prrow.Data, 0, len(res.Prs))
+	for _, pr := range res.Prs {
+		prs = append(prs, prrow.Data{Primary: &pr})
+	}
+	m.Prs = prs
+	m.sortPrs()
+	m.groupPrs()
+	m.TotalCount = res.TotalCount
+	m.Table.SetRows(m.BuildRows())
+	m.Table.UpdateLastUpdated(fetchedAt)
+	m.UpdateTotalItemsCount(m.TotalCount)
+	return true
+}
+
 func (m *Model) ResetRows() {
 	m.Prs = nil
 	m.BaseModel.ResetRows()
@@ -530,6 +659,9 @@ func FetchAllSections(
 ) (sections []section.Section, fetchAllCmd tea.Cmd) {
 	fetchPRsCmds := make([]tea.Cmd, 0, len(ctx.Config.PRSections))
 	sections = make([]section.Section, 0, len(ctx.Config.PRSections))
+	// The first pages of all the sections are fetched in as few requests as
+	// possible
+	batch := data.NewPullRequestsBatch()
 	for i, sectionConfig := range ctx.Config.PRSections {
 		sectionModel := NewModel(
 			i+1, // 0 is the search section
@@ -542,10 +674,16 @@ func FetchAllSections(
 			oldSection := prs[i+1].(*Model)
 			sectionModel.Prs = oldSection.Prs
 			sectionModel.LastFetchTaskId = oldSection.LastFetchTaskId
+			sectionModel.SelectedUrls = oldSection.SelectedUrls
+			sectionModel.fetchedPrs = oldSection.fetchedPrs
+			sectionModel.SortKeys = oldSection.SortKeys
+			sectionModel.Table.SetCollapsedGroups(oldSection.Table.CollapsedGroups())
+			sectionModel.groupPrs()
 		}
 		if sectionConfig.Layout.AuthorIcon.Hidden != nil {
 			sectionModel.ShowAuthorIcon = !*sectionConfig.Layout.AuthorIcon.Hidden
 		}
+		sectionModel.batch = batch
 		sections = append(sections, &sectionModel)
 		fetchPRsCmds = append(
 			fetchPRsCmds,
@@ -618,6 +756,9 @@ func (m Model) GetPagerContent() string {
 			len(m.Table.Rows),
 		)
 	}
+	if n := m.NumSelected(); n > 0 {
+		pagerContent += fmt.Sprintf(" • %d selected", n)
+	}
 	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
 	return pager
 }
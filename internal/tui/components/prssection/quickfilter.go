@@ -0,0 +1,23 @@
+package prssection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// FilterableRows returns the fields the quick filter matches the PRs on
+func (m *Model) FilterableRows() []section.FilterableRow {
+	rows := make([]section.FilterableRow, 0, len(m.Prs))
+	for _, pr := range m.Prs {
+		row := section.FilterableRow{
+			Title:  pr.Primary.Title,
+			Author: pr.Primary.Author.Login,
+			Repo:   pr.Primary.GetRepoNameWithOwner(),
+			Branch: pr.Primary.HeadRefName,
+		}
+		for _, label := range pr.Primary.Labels.Nodes {
+			row.Labels = append(row.Labels, label.Name)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
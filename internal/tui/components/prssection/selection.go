@@ -0,0 +1,121 @@
+package prssection
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+func (m *Model) ToggleCurrRowSelection() {
+	pr := m.GetCurrRow()
+	if pr == nil {
+		return
+	}
+	m.ToggleSelected(pr.GetUrl())
+	m.syncSelection()
+}
+
+// SelectAllRows selects all the PRs fetched so far, except those hidden by
+// the quick filter
+func (m *Model) SelectAllRows() {
+	m.SelectRows(m.shownRowUrls())
+	m.syncSelection()
+}
+
+// SelectMatchingRows selects the shown PRs fetched so far that match the
+// filter and returns how many matched
+func (m *Model) SelectMatchingRows(filter string) int {
+	var urls []string
+	for i, pr := range m.Prs {
+		if !m.Table.IsRowShown(i) {
+			continue
+		}
+		row := section.SelectableRow{
+			Url:     pr.Primary.Url,
+			Number:  pr.Primary.Number,
+			Title:   pr.Primary.Title,
+			Repo:    pr.Primary.GetRepoNameWithOwner(),
+			Author:  pr.Primary.Author.Login,
+			State:   pr.Primary.State,
+			IsDraft: pr.Primary.IsDraft,
+		}
+		for _, label := range pr.Primary.Labels.Nodes {
+			row.Labels = append(row.Labels, label.Name)
+		}
+		if row.Matches(filter) {
+			urls = append(urls, row.Url)
+		}
+	}
+	m.SelectRows(urls)
+	m.syncSelection()
+	return len(urls)
+}
+
+func (m *Model) GetSelectedRows() []data.RowData {
+	prs := m.GetSelectedPrs()
+	rows := make([]data.RowData, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, pr)
+	}
+	return rows
+}
+
+// GetSelectedPrs returns the selected PRs in the order they're shown
+func (m *Model) GetSelectedPrs() []data.PullRequestData {
+	var prs []data.PullRequestData
+	for _, pr := range m.Prs {
+		if m.IsSelected(pr.Primary.Url) {
+			prs = append(prs, *pr.Primary)
+		}
+	}
+	return prs
+}
+
+func (m *Model) rowUrls() []string {
+	urls := make([]string, 0, len(m.Prs))
+	for _, pr := range m.Prs {
+		urls = append(urls, pr.Primary.Url)
+	}
+	return urls
+}
+
+func (m *Model) shownRowUrls() []string {
+	var urls []string
+	for i, url := range m.rowUrls() {
+		if m.Table.IsRowShown(i) {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+func (m *Model) syncSelection() {
+	m.MarkSelectedRows(m.rowUrls())
+	m.Table.SyncViewPortContent()
+}
+
+// runBulkAction runs the confirmed action over the selected PRs and clears the
+// selection. ok is false if nothing is selected or the action only runs on the
+// current PR.
+func (m *Model) runBulkAction(sid tasks.SectionIdentifier, action string) (cmd tea.Cmd, ok bool) {
+	prs := m.GetSelectedRows()
+	if len(prs) == 0 {
+		return nil, false
+	}
+
+	switch action {
+	case "close":
+		cmd = tasks.ClosePRs(m.Ctx, sid, prs)
+	case "reopen":
+		cmd = tasks.ReopenPRs(m.Ctx, sid, prs)
+	case "merge":
+		cmd = tasks.MergePRs(m.Ctx, sid, prs)
+	default:
+		return nil, false
+	}
+
+	m.ClearSelection()
+	return cmd, true
+}
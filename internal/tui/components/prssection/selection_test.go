@@ -0,0 +1,112 @@
+package prssection
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+)
+
+func newSelectionTestModel() Model {
+	m := newTestModel("select")
+	m.Prs = []prrow.Data{
+		{Primary: &data.PullRequestData{
+			Number: 1,
+			Url:    "https://github.com/owner/repo/pull/1",
+			Title:  "Fix crash on startup",
+			State:  "OPEN",
+			Labels: data.PRLabels{Nodes: []data.Label{{Name: "bug"}}},
+		}},
+		{Primary: &data.PullRequestData{
+			Number: 2,
+			Url:    "https://github.com/owner/repo/pull/2",
+			Title:  "Add dark theme",
+			State:  "OPEN",
+		}},
+		{Primary: &data.PullRequestData{
+			Number: 3,
+			Url:    "https://github.com/owner/repo/pull/3",
+			Title:  "Fix typo in docs",
+			State:  "CLOSED",
+		}},
+	}
+	return m
+}
+
+func TestSelection_ToggleCurrRow(t *testing.T) {
+	m := newSelectionTestModel()
+
+	m.ToggleCurrRowSelection()
+	require.Equal(t, 1, m.NumSelected())
+	require.Equal(t, []int{1}, selectedNumbers(m))
+
+	m.ToggleCurrRowSelection()
+	require.Equal(t, 0, m.NumSelected())
+}
+
+func TestSelection_SelectAllRows(t *testing.T) {
+	m := newSelectionTestModel()
+
+	m.SelectAllRows()
+
+	require.Equal(t, []int{1, 2, 3}, selectedNumbers(m))
+}
+
+func TestSelection_SelectMatchingFromPrompt(t *testing.T) {
+	m := newSelectionTestModel()
+	m.PromptConfirmationBox.SetValue("fix is:open")
+
+	_, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	require.False(t, m.IsPromptConfirmationShown)
+	require.Equal(t, []int{1}, selectedNumbers(m))
+}
+
+func TestSelection_SelectMatchingWithoutMatches(t *testing.T) {
+	m := newSelectionTestModel()
+	m.PromptConfirmationBox.SetValue("label:enhancement")
+
+	_, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	require.Equal(t, 0, m.NumSelected())
+	require.Error(t, m.Ctx.Error)
+}
+
+func selectedNumbers(m Model) []int {
+	var numbers []int
+	for _, row := range m.GetSelectedRows() {
+		numbers = append(numbers, row.GetNumber())
+	}
+	return numbers
+}
+
+func TestUnenrichedNeighbours(t *testing.T) {
+	m := newSelectionTestModel()
+	m.Prs[1].IsEnriched = true
+
+	var numbers []int
+	for _, pr := range m.UnenrichedNeighbours(2) {
+		numbers = append(numbers, pr.Number)
+	}
+	require.Equal(t, []int{3}, numbers)
+	require.Empty(t, m.UnenrichedNeighbours(0))
+}
+
+func TestSelection_SelectAllRowsWithQuickFilter(t *testing.T) {
+	m := newSelectionTestModel()
+	m.QuickFilter = "fix"
+	m.ApplyQuickFilter(m.FilterableRows())
+
+	m.SelectAllRows()
+	require.Equal(t, []int{1, 3}, selectedNumbers(m))
+
+	m.ClearSelection()
+	require.Equal(t, 1, m.SelectMatchingRows("is:closed"))
+	require.Equal(t, []int{3}, selectedNumbers(m))
+
+	m.ClearSelection()
+	require.Equal(t, 0, m.SelectMatchingRows("dark"))
+}
@@ -0,0 +1,46 @@
+package prssection
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// sortKeys are the keys PR sections cycle through
+var sortKeys = []config.SortKey{
+	config.SortByUpdated,
+	config.SortByCreated,
+	config.SortByChecks,
+	config.SortByReviewDecision,
+	config.SortByLinesChanged,
+	config.SortByComments,
+	config.SortByRepo,
+	config.SortByAuthor,
+}
+
+func (m *Model) CycleSortKey() config.SortKey {
+	m.SortKeys = section.NextSortKeys(m.SortKeys, m.Config.Sort, sortKeys)
+	m.sortPrs()
+	m.groupPrs()
+	m.Table.SetRows(m.BuildRows())
+	m.ApplyQuickFilter(m.FilterableRows())
+	return m.SortKeys[0]
+}
+
+func (m *Model) sortPrs() {
+	section.SortRows(m.Prs, m.SortKeys, func(pr prrow.Data) section.SortableRow {
+		row := section.SortableRow{
+			UpdatedAt:      pr.Primary.UpdatedAt,
+			CreatedAt:      pr.Primary.CreatedAt,
+			ReviewDecision: pr.Primary.ReviewDecision,
+			LinesChanged:   pr.Primary.Additions + pr.Primary.Deletions,
+			Comments:       pr.Primary.Comments.TotalCount,
+			Repo:           pr.Primary.Repository.NameWithOwner,
+			Author:         pr.Primary.Author.Login,
+		}
+		if commits := pr.Primary.Commits.Nodes; len(commits) > 0 {
+			row.ChecksState = string(commits[0].Commit.StatusCheckRollup.State)
+		}
+		return row
+	})
+}
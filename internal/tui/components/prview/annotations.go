@@ -0,0 +1,274 @@
+package prview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// checkAnnotation is an annotation along with the name of the check run that
+// reported it
+type checkAnnotation struct {
+	data.CheckAnnotation
+	checkName string
+}
+
+// annotationsState holds the annotations fetched for check runs, by the id of
+// the check run. They're fetched on demand as including them in the PR query
+// makes it a lot more costly.
+type annotationsState struct {
+	fetched  map[string]data.CheckAnnotations
+	fetching map[string]bool
+}
+
+type CheckRunAnnotationsFetchedMsg struct {
+	CheckRunId  string
+	Annotations data.CheckAnnotations
+	Err         error
+}
+
+// FetchShownAnnotations fetches the annotations the selected tab shows that
+// weren't fetched yet: the ones of the selected check run on the "Checks" tab
+// and the ones of every check run on the "Files Changed" tab
+func (m *Model) FetchShownAnnotations() tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	runs := m.checkRuns()
+	switch m.carousel.SelectedItem() {
+	case tabs[checksTabIndex]:
+		cursor := 0
+		if m.checks.prUrl == m.pr.Data.Primary.Url {
+			cursor = m.checks.cursor
+		}
+		if cursor < len(runs) {
+			return m.fetchAnnotations(runs[cursor])
+		}
+	case tabs[filesTabIndex]:
+		return m.fetchAnnotations(runs...)
+	}
+	return nil
+}
+
+// fetchAnnotations fetches the annotations of the failing check runs out of
+// runs. Annotations of check runs that didn't fail are rarely worth the
+// request.
+func (m *Model) fetchAnnotations(runs ...data.CheckRun) tea.Cmd {
+	if m.runAnnotations.fetched == nil {
+		m.runAnnotations = annotationsState{
+			fetched:  make(map[string]data.CheckAnnotations),
+			fetching: make(map[string]bool),
+		}
+	}
+
+	host := data.HostOf(m.pr.Data.Primary.Url)
+	cmds := make([]tea.Cmd, 0)
+	for _, checkRun := range runs {
+		id := checkRun.Id
+		if id == "" || m.runAnnotations.fetching[id] {
+			continue
+		}
+		if _, ok := m.runAnnotations.fetched[id]; ok {
+			continue
+		}
+		if category, _ := m.renderCheckRunConclusion(checkRun); category != CheckFailure {
+			continue
+		}
+
+		m.runAnnotations.fetching[id] = true
+		cmds = append(cmds, func() tea.Msg {
+			annotations, err := data.FetchCheckRunAnnotations(id, host)
+			return CheckRunAnnotationsFetchedMsg{CheckRunId: id, Annotations: annotations, Err: err}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *Model) onAnnotationsFetched(msg CheckRunAnnotationsFetchedMsg) {
+	delete(m.runAnnotations.fetching, msg.CheckRunId)
+	if msg.Err != nil {
+		m.ctx.Error = fmt.Errorf("failed fetching the annotations of the check: %w", msg.Err)
+		return
+	}
+	m.runAnnotations.fetched[msg.CheckRunId] = msg.Annotations
+}
+
+// annotationSeverity orders the annotation levels, most severe first
+func annotationSeverity(level string) int {
+	switch level {
+	case "FAILURE":
+		return 0
+	case "WARNING":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// annotations returns the fetched annotations reported by the check runs of
+// the last commit, ordered by file and line, and how many more weren't fetched
+func (m *Model) annotations() ([]checkAnnotation, int) {
+	if !m.pr.Data.IsEnriched || len(m.pr.Data.Enriched.Commits.Nodes) == 0 {
+		return nil, 0
+	}
+
+	annotations := make([]checkAnnotation, 0)
+	missing := 0
+	lastCommit := m.pr.Data.Enriched.Commits.Nodes[0]
+	for _, node := range lastCommit.Commit.StatusCheckRollup.Contexts.Nodes {
+		if node.Typename != "CheckRun" {
+			continue
+		}
+		checkRun := node.CheckRun
+		fetched, ok := m.runAnnotations.fetched[checkRun.Id]
+		if !ok {
+			continue
+		}
+		for _, annotation := range fetched.Nodes {
+			annotations = append(annotations, checkAnnotation{
+				CheckAnnotation: annotation,
+				checkName:       string(checkRun.Name),
+			})
+		}
+		missing += max(0, int(fetched.TotalCount)-len(fetched.Nodes))
+	}
+
+	sort.SliceStable(annotations, func(i, j int) bool {
+		a, b := annotations[i], annotations[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Location.Start.Line != b.Location.Start.Line {
+			return a.Location.Start.Line < b.Location.Start.Line
+		}
+		return annotationSeverity(string(a.AnnotationLevel)) <
+			annotationSeverity(string(b.AnnotationLevel))
+	})
+	return annotations, missing
+}
+
+// fileAnnotations returns the annotations of the file at path
+func (m *Model) fileAnnotations(path string) []checkAnnotation {
+	annotations, _ := m.annotations()
+	res := make([]checkAnnotation, 0)
+	for _, annotation := range annotations {
+		if string(annotation.Path) == path {
+			res = append(res, annotation)
+		}
+	}
+	return res
+}
+
+// annotatedLines returns the most severe annotation level of each line of
+// the file at path that has annotations
+func (m *Model) annotatedLines(path string) map[int]string {
+	lines := make(map[int]string)
+	for _, annotation := range m.fileAnnotations(path) {
+		level := string(annotation.AnnotationLevel)
+		start := int(annotation.Location.Start.Line)
+		end := max(start, int(annotation.Location.End.Line))
+		for line := start; line <= end; line++ {
+			if current, ok := lines[line]; !ok ||
+				annotationSeverity(level) < annotationSeverity(current) {
+				lines[line] = level
+			}
+		}
+	}
+	return lines
+}
+
+func (m *Model) renderAnnotationGlyph(level string) string {
+	switch level {
+	case "FAILURE":
+		return m.ctx.Styles.Common.FailureGlyph
+	case "WARNING":
+		return m.ctx.Styles.Common.ActionRequiredGlyph
+	default:
+		return m.ctx.Styles.Common.FaintTextStyle.Render(constants.NoticeIcon)
+	}
+}
+
+// renderAnnotationCounts renders how many annotations of each level the file
+// at path has, or nothing when it has none
+func (m *Model) renderAnnotationCounts(path string) string {
+	counts := make(map[string]int)
+	for _, annotation := range m.fileAnnotations(path) {
+		counts[string(annotation.AnnotationLevel)]++
+	}
+
+	parts := make([]string, 0, 3)
+	for _, level := range []string{"FAILURE", "WARNING", "NOTICE"} {
+		if counts[level] > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", m.renderAnnotationGlyph(level), counts[level]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func annotationLines(annotation checkAnnotation) string {
+	start, end := annotation.Location.Start.Line, annotation.Location.End.Line
+	if end > start {
+		return fmt.Sprintf("L%d-%d", start, end)
+	}
+	return fmt.Sprintf("L%d", start)
+}
+
+// renderAnnotations renders the annotations of the checks grouped by file
+func (m *Model) renderAnnotations() string {
+	annotations, missing := m.annotations()
+	if len(annotations) == 0 {
+		return ""
+	}
+
+	styles := m.ctx.Styles.Common
+	width := m.getIndentedContentWidth()
+	title := styles.MainTextStyle.MarginBottom(1).Underline(true).Render(
+		fmt.Sprintf(" Annotations (%d)", len(annotations)+missing))
+
+	parts := make([]string, 0)
+	for i, annotation := range annotations {
+		if i == 0 || annotations[i-1].Path != annotation.Path {
+			if i != 0 {
+				parts = append(parts, "")
+			}
+			parts = append(parts, styles.MainTextStyle.Render(string(annotation.Path)))
+		}
+
+		header := lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.renderAnnotationGlyph(string(annotation.AnnotationLevel)),
+			" ",
+			styles.MainTextStyle.Render(annotationLines(annotation)),
+			" ",
+			styles.FaintTextStyle.Render(annotation.checkName),
+		)
+
+		message := strings.TrimSpace(string(annotation.Message))
+		if t := strings.TrimSpace(string(annotation.Title)); t != "" {
+			message = t + ": " + message
+		}
+		parts = append(parts, header, lipgloss.NewStyle().
+			PaddingLeft(2).
+			Width(max(0, width-2)).
+			Render(message))
+	}
+
+	if missing > 0 {
+		parts = append(parts, "", styles.FaintTextStyle.Render(
+			fmt.Sprintf("%d more annotations aren't shown", missing)))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		lipgloss.NewStyle().PaddingLeft(2).Width(width).Render(
+			lipgloss.JoinVertical(lipgloss.Left, parts...)),
+	)
+}
@@ -0,0 +1,136 @@
+package prview
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+func makeAnnotation(path string, start, end int, level, message string) data.CheckAnnotation {
+	annotation := data.CheckAnnotation{
+		Path:            graphql.String(path),
+		AnnotationLevel: graphql.String(level),
+		Message:         graphql.String(message),
+	}
+	annotation.Location.Start.Line = graphql.Int(start)
+	annotation.Location.End.Line = graphql.Int(end)
+	return annotation
+}
+
+func newAnnotationsTestModel(t *testing.T) Model {
+	t.Helper()
+	lint := makeCheckRun("lint", "COMPLETED", "FAILURE")
+	lint.Id, lint.DatabaseId = "CR_lint", 1
+	typecheck := makeCheckRun("typecheck", "COMPLETED", "SUCCESS")
+	typecheck.Id, typecheck.DatabaseId = "CR_typecheck", 2
+
+	m := newTestModelForChecks(t, checksTestOptions{
+		checkRuns:   []data.CheckRun{lint, typecheck},
+		rollupState: "FAILURE",
+	})
+	m.runAnnotations = annotationsState{
+		fetched: map[string]data.CheckAnnotations{
+			"CR_lint": {
+				TotalCount: 4,
+				Nodes: []data.CheckAnnotation{
+					makeAnnotation("b.go", 3, 3, "NOTICE", "consider a shorter name"),
+					makeAnnotation("a.go", 10, 12, "WARNING", "unused variable"),
+					makeAnnotation("b.go", 3, 3, "FAILURE", "undefined: foo"),
+				},
+			},
+			"CR_typecheck": {
+				TotalCount: 1,
+				Nodes: []data.CheckAnnotation{
+					makeAnnotation("a.go", 1, 0, "FAILURE", "missing return"),
+				},
+			},
+		},
+		fetching: map[string]bool{},
+	}
+	return m
+}
+
+func TestFetchShownAnnotationsOnlyFetchesFailingCheckRuns(t *testing.T) {
+	m := newAnnotationsTestModel(t)
+	m.runAnnotations = annotationsState{}
+
+	m.carousel.SetCursor(0)
+	require.Nil(t, m.FetchShownAnnotations())
+
+	m.carousel.SetCursor(filesTabIndex)
+	require.NotNil(t, m.FetchShownAnnotations())
+	require.Equal(t, map[string]bool{"CR_lint": true}, m.runAnnotations.fetching)
+	require.Nil(t, m.FetchShownAnnotations(), "annotations being fetched aren't fetched again")
+
+	m.onAnnotationsFetched(CheckRunAnnotationsFetchedMsg{
+		CheckRunId: "CR_lint",
+		Annotations: data.CheckAnnotations{
+			TotalCount: 1,
+			Nodes:      []data.CheckAnnotation{makeAnnotation("a.go", 1, 1, "FAILURE", "oops")},
+		},
+	})
+	require.Empty(t, m.runAnnotations.fetching)
+	require.Nil(t, m.FetchShownAnnotations(), "fetched annotations aren't fetched again")
+
+	annotations, missing := m.annotations()
+	require.Len(t, annotations, 1)
+	require.Equal(t, "lint", annotations[0].checkName)
+	require.Zero(t, missing)
+}
+
+func TestAnnotationsOrderedByFileAndLine(t *testing.T) {
+	m := newAnnotationsTestModel(t)
+
+	annotations, missing := m.annotations()
+
+	var messages []string
+	for _, annotation := range annotations {
+		messages = append(messages, string(annotation.Message))
+	}
+	require.Equal(t, []string{
+		"missing return",
+		"unused variable",
+		"undefined: foo",
+		"consider a shorter name",
+	}, messages)
+	require.Equal(t, 1, missing)
+}
+
+func TestAnnotatedLinesKeepMostSevereLevel(t *testing.T) {
+	m := newAnnotationsTestModel(t)
+
+	require.Equal(t, map[int]string{3: "FAILURE"}, m.annotatedLines("b.go"))
+	require.Equal(t, map[int]string{
+		1:  "FAILURE",
+		10: "WARNING",
+		11: "WARNING",
+		12: "WARNING",
+	}, m.annotatedLines("a.go"))
+}
+
+func TestRenderAnnotationsGroupedByFile(t *testing.T) {
+	m := newAnnotationsTestModel(t)
+	m.carousel.SetCursor(checksTabIndex)
+
+	view := ansi.Strip(m.View())
+
+	require.Contains(t, view, "Annotations (5)")
+	require.Contains(t, view, "L10-12 lint")
+	require.Contains(t, view, "L1 typecheck")
+	require.Contains(t, view, "undefined: foo")
+	require.Contains(t, view, "1 more annotations aren't shown")
+}
+
+func TestRenderFileShowsAnnotationCounts(t *testing.T) {
+	m := newAnnotationsTestModel(t)
+
+	file := ansi.Strip(m.renderFile(data.ChangedFile{Path: "b.go", ChangeType: "MODIFIED"}))
+
+	require.Contains(t, file, "b.go "+constants.FailureIcon+" 1 "+constants.NoticeIcon+" 1")
+	require.Empty(t, m.renderAnnotationCounts("c.go"))
+}
@@ -0,0 +1,304 @@
+package prview
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+const (
+	checksTabIndex = 3
+	// maxJobLogLines is how many lines from the end of a job log are shown
+	maxJobLogLines = 60
+)
+
+// checksState holds the navigation state of the "Checks" tab.
+// It's kept per PR and reset whenever the viewed PR changes.
+type checksState struct {
+	prUrl        string
+	isNavigating bool
+	cursor       int
+	log          *jobLogState
+}
+
+// jobLogState is the log of the job of a check run, once it's requested
+type jobLogState struct {
+	jobId     int64
+	isLoading bool
+	err       error
+	job       data.WorkflowJob
+	lines     []data.JobLogLine
+	// skipped is how many lines of the log came before the fetched ones
+	skipped int
+}
+
+type JobLogFetchedMsg struct {
+	PrUrl   string
+	JobId   int64
+	Job     data.WorkflowJob
+	Lines   []data.JobLogLine
+	Skipped int
+	Err     error
+}
+
+func (m *Model) IsNavigatingChecks() bool {
+	return m.hasData() && m.checks.isNavigating && m.checks.prUrl == m.pr.Data.Primary.Url
+}
+
+// StartNavigatingChecks switches to the "Checks" tab and lets the user pick a
+// check run to view the log of, re-run or cancel
+func (m *Model) StartNavigatingChecks() tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	m.carousel.SetCursor(checksTabIndex)
+	if m.checks.prUrl != m.pr.Data.Primary.Url {
+		m.checks = checksState{prUrl: m.pr.Data.Primary.Url}
+	}
+	m.checks.isNavigating = true
+	m.checks.cursor = min(m.checks.cursor, max(0, len(m.checkRuns())-1))
+	return m.FetchShownAnnotations()
+}
+
+// checkRuns returns the check runs of the last commit in the order they're
+// shown in: failures, then checks still running and then the rest. Status
+// contexts aren't included as there's nothing to drill down into.
+func (m *Model) checkRuns() []data.CheckRun {
+	if !m.pr.Data.IsEnriched || len(m.pr.Data.Enriched.Commits.Nodes) == 0 {
+		return nil
+	}
+
+	var failures, waiting, rest []data.CheckRun
+	lastCommit := m.pr.Data.Enriched.Commits.Nodes[0]
+	for _, node := range lastCommit.Commit.StatusCheckRollup.Contexts.Nodes {
+		if node.Typename != "CheckRun" || node.CheckRun.DatabaseId == 0 {
+			continue
+		}
+		switch category, _ := m.renderCheckRunConclusion(node.CheckRun); category {
+		case CheckWaiting:
+			waiting = append(waiting, node.CheckRun)
+		case CheckFailure:
+			failures = append(failures, node.CheckRun)
+		default:
+			rest = append(rest, node.CheckRun)
+		}
+	}
+
+	runs := make([]data.CheckRun, 0, len(failures)+len(waiting)+len(rest))
+	runs = append(runs, failures...)
+	runs = append(runs, waiting...)
+	return append(runs, rest...)
+}
+
+func (m *Model) selectedCheckRun() *data.CheckRun {
+	if !m.IsNavigatingChecks() {
+		return nil
+	}
+	runs := m.checkRuns()
+	if m.checks.cursor >= len(runs) {
+		return nil
+	}
+	return &runs[m.checks.cursor]
+}
+
+func (m *Model) isSelectedCheckRun(checkRun data.CheckRun) bool {
+	selected := m.selectedCheckRun()
+	return selected != nil && selected.DatabaseId == checkRun.DatabaseId
+}
+
+func (m *Model) updateChecks(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.CheckRunKeys.Down):
+		m.moveChecksCursor(1)
+		return m.FetchShownAnnotations()
+	case key.Matches(msg, keys.CheckRunKeys.Up):
+		m.moveChecksCursor(-1)
+		return m.FetchShownAnnotations()
+	case key.Matches(msg, keys.CheckRunKeys.ViewLog):
+		return m.fetchSelectedJobLog()
+	case key.Matches(msg, keys.CheckRunKeys.RerunFailed):
+		return m.runWorkflowAction(tasks.RerunFailedJobs)
+	case key.Matches(msg, keys.CheckRunKeys.RerunAll):
+		return m.runWorkflowAction(tasks.RerunAllJobs)
+	case key.Matches(msg, keys.CheckRunKeys.Cancel):
+		return m.runWorkflowAction(tasks.CancelRun)
+	case key.Matches(msg, keys.CheckRunKeys.Back):
+		if m.checks.log != nil {
+			m.checks.log = nil
+		} else {
+			m.checks.isNavigating = false
+		}
+	}
+
+	return nil
+}
+
+func (m *Model) moveChecksCursor(delta int) {
+	m.checks.cursor = max(0, min(m.checks.cursor+delta, len(m.checkRuns())-1))
+	m.checks.log = nil
+}
+
+func (m *Model) fetchSelectedJobLog() tea.Cmd {
+	checkRun := m.selectedCheckRun()
+	if checkRun == nil {
+		return nil
+	}
+	if checkRun.CheckSuite.WorkflowRun.DatabaseId == 0 {
+		m.ctx.Error = fmt.Errorf("%s isn't a GitHub Actions job", checkRun.Name)
+		return nil
+	}
+
+	jobId := checkRun.DatabaseId
+	m.checks.log = &jobLogState{jobId: jobId, isLoading: true}
+
+	url := m.pr.Data.Primary.Url
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	host := data.HostOf(url)
+	return func() tea.Msg {
+		job, err := data.FetchWorkflowJob(repo, jobId, host)
+		if err != nil {
+			return JobLogFetchedMsg{PrUrl: url, JobId: jobId, Err: err}
+		}
+		log, skipped, err := data.FetchJobLog(repo, jobId, host, maxJobLogLines)
+		return JobLogFetchedMsg{
+			PrUrl:   url,
+			JobId:   jobId,
+			Job:     job,
+			Lines:   data.ParseJobLog(log),
+			Skipped: skipped,
+			Err:     err,
+		}
+	}
+}
+
+func (m *Model) onJobLogFetched(msg JobLogFetchedMsg) {
+	if msg.PrUrl != m.checks.prUrl || m.checks.log == nil || msg.JobId != m.checks.log.jobId {
+		return
+	}
+	m.checks.log.isLoading = false
+	m.checks.log.err = msg.Err
+	m.checks.log.job = msg.Job
+	m.checks.log.lines = msg.Lines
+	m.checks.log.skipped = msg.Skipped
+}
+
+func (m *Model) runWorkflowAction(action tasks.WorkflowRunAction) tea.Cmd {
+	checkRun := m.selectedCheckRun()
+	if checkRun == nil {
+		return nil
+	}
+
+	runId := checkRun.CheckSuite.WorkflowRun.DatabaseId
+	if runId == 0 {
+		m.ctx.Error = fmt.Errorf("%s isn't a GitHub Actions job", checkRun.Name)
+		return nil
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	return tasks.RunWorkflowAction(m.ctx, sid, m.pr.Data.Primary, runId, action)
+}
+
+// ChecksCursorLine returns the line of the rendered view the selected check
+// run is on, or the line its log starts on when it's shown, so the sidebar
+// can keep it visible
+func (m *Model) ChecksCursorLine() int {
+	if !m.IsNavigatingChecks() {
+		return 0
+	}
+
+	line := lipgloss.Height(m.viewHeader()) + lipgloss.Height(m.renderChecksOverview()) + 1
+	checks, cursorLine := m.renderChecksList()
+	if m.checks.log != nil {
+		return line + lipgloss.Height(checks) + 1
+	}
+	return line + cursorLine
+}
+
+func (m *Model) renderChecksHint() string {
+	if m.IsNavigatingChecks() || len(m.checkRuns()) == 0 {
+		return ""
+	}
+	return m.ctx.Styles.Common.FaintTextStyle.Render(
+		fmt.Sprintf("Press %s to view logs, re-run or cancel checks",
+			keys.PRKeys.InspectChecks.Help().Key),
+	)
+}
+
+func (m *Model) renderSelectedCheck(check string) string {
+	return lipgloss.NewStyle().
+		Background(m.ctx.Theme.SelectedBackground).
+		Width(max(0, m.getIndentedContentWidth()-2)).
+		Render(check)
+}
+
+func (m *Model) renderJobLog() string {
+	jobLog := m.checks.log
+	if jobLog == nil || !m.IsNavigatingChecks() {
+		return ""
+	}
+
+	styles := m.ctx.Styles.Common
+	title := styles.MainTextStyle.MarginBottom(1).Underline(true).Render(" Job Log")
+	if jobLog.isLoading {
+		return lipgloss.JoinVertical(lipgloss.Left, title, styles.FaintTextStyle.Render("Loading..."))
+	}
+	if jobLog.err != nil {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			title,
+			styles.ErrorStyle.Render(fmt.Sprintf("Failed fetching the job log: %v", jobLog.err)),
+		)
+	}
+
+	parts := []string{title}
+	if failed := jobLog.job.FailedSteps(); len(failed) > 0 {
+		parts = append(parts, lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			styles.FailureGlyph,
+			" ",
+			styles.ErrorStyle.Render("Failed at "+strings.Join(failed, ", ")),
+		), "")
+	}
+
+	lines, earlier := jobLog.lines, jobLog.skipped
+	if len(lines) > maxJobLogLines {
+		earlier += len(lines) - maxJobLogLines
+		lines = lines[len(lines)-maxJobLogLines:]
+	}
+	if earlier > 0 {
+		parts = append(parts, styles.FaintTextStyle.Render(
+			fmt.Sprintf("… %d earlier lines", earlier)))
+	}
+
+	width := max(0, m.getIndentedContentWidth()-4)
+	gutter := lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render("┃ ")
+	for _, line := range lines {
+		text := ansi.Truncate(line.Text, width, "…")
+		switch {
+		case line.IsError:
+			text = styles.ErrorStyle.Render(text)
+		case line.IsGroup:
+			text = styles.MainTextStyle.Bold(true).Render(text)
+		case !line.InFailure:
+			text = styles.FaintTextStyle.Render(text)
+		}
+		if line.InFailure {
+			text = gutter + text
+		} else {
+			text = "  " + text
+		}
+		parts = append(parts, text)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
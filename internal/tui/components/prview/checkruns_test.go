@@ -0,0 +1,125 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	checks "github.com/dlvhdr/x/gh-checks"
+)
+
+func makeJobCheckRun(
+	jobId int64,
+	runId int64,
+	name string,
+	status string,
+	conclusion checks.CheckRunState,
+) data.CheckRun {
+	checkRun := makeCheckRun(name, status, conclusion)
+	checkRun.DatabaseId = jobId
+	checkRun.CheckSuite.WorkflowRun.DatabaseId = runId
+	return checkRun
+}
+
+func newCheckRunsTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModelForChecks(t, checksTestOptions{
+		checkRuns: []data.CheckRun{
+			makeJobCheckRun(1, 10, "lint", "COMPLETED", "SUCCESS"),
+			makeJobCheckRun(2, 10, "build", "IN_PROGRESS", ""),
+			makeJobCheckRun(3, 20, "test", "COMPLETED", "FAILURE"),
+			makeJobCheckRun(4, 0, "external", "COMPLETED", "SUCCESS"),
+		},
+		rollupState: "FAILURE",
+	})
+	m.StartNavigatingChecks()
+	return m
+}
+
+func TestCheckRunsNavigation(t *testing.T) {
+	m := newCheckRunsTestModel(t)
+	require.True(t, m.IsNavigatingChecks())
+	require.Equal(t, checksTabIndex, m.carousel.Cursor())
+
+	var names []string
+	for _, checkRun := range m.checkRuns() {
+		names = append(names, string(checkRun.Name))
+	}
+	require.Equal(t, []string{"test", "build", "lint", "external"}, names)
+	require.Equal(t, "test", string(m.selectedCheckRun().Name))
+
+	for range 5 {
+		m.updateChecks(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	}
+	require.Equal(t, "external", string(m.selectedCheckRun().Name),
+		"cursor should stop at the last check run")
+
+	m.updateChecks(tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.False(t, m.IsNavigatingChecks())
+}
+
+func TestCheckRunsCursorLine(t *testing.T) {
+	m := newCheckRunsTestModel(t)
+	first := m.ChecksCursorLine()
+
+	m.updateChecks(tea.KeyPressMsg{Code: 'j', Text: "j"})
+
+	require.Equal(t, first+1, m.ChecksCursorLine())
+	lines := ansi.Strip(m.View())
+	require.Contains(t, lines, "build")
+}
+
+func TestCheckRunsWorkflowAction(t *testing.T) {
+	m := newCheckRunsTestModel(t)
+	var started context.Task
+	m.ctx.StartTask = func(task context.Task) tea.Cmd {
+		started = task
+		return nil
+	}
+
+	cmd := m.updateChecks(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	require.NotNil(t, cmd)
+	require.Equal(t, "Re-running failed jobs of run 20", started.StartText)
+
+	// Checks that aren't GitHub Actions jobs have no run to re-run
+	for range 3 {
+		m.updateChecks(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	}
+	cmd = m.updateChecks(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	require.Nil(t, cmd)
+	require.Error(t, m.ctx.Error)
+}
+
+func TestCheckRunsJobLog(t *testing.T) {
+	m := newCheckRunsTestModel(t)
+
+	cmd := m.updateChecks(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	require.Contains(t, ansi.Strip(m.View()), "Loading...")
+
+	m, _ = m.Update(JobLogFetchedMsg{
+		JobId: 3,
+		Job: data.WorkflowJob{Steps: []data.JobStep{
+			{Name: "Checkout", Conclusion: "success"},
+			{Name: "Run tests", Conclusion: "failure"},
+		}},
+		Lines: data.ParseJobLog("##[group]Run go test ./...\n" +
+			"--- FAIL: TestSomething\n" +
+			"##[error]Process completed with exit code 1.\n"),
+		Skipped: 120,
+	})
+
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "Failed at Run tests")
+	require.Contains(t, view, "┃ --- FAIL: TestSomething")
+	require.Contains(t, view, "… 120 earlier lines")
+
+	// A log fetched for another job is dropped
+	m.updateChecks(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	m, _ = m.Update(JobLogFetchedMsg{JobId: 3})
+	require.Nil(t, m.checks.log)
+}
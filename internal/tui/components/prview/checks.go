@@ -421,6 +421,13 @@ func renderStatusContextName(statusContext data.StatusContext) string {
 }
 
 func (sidebar *Model) renderChecks() string {
+	checks, _ := sidebar.renderChecksList()
+	return checks
+}
+
+// renderChecksList renders the checks of the last commit and returns the line
+// the selected check run is on
+func (sidebar *Model) renderChecksList() (string, int) {
 	title := sidebar.ctx.Styles.Common.MainTextStyle.MarginBottom(1).
 		Underline(true).
 		Render(" All Checks")
@@ -431,7 +438,7 @@ func (sidebar *Model) renderChecks() string {
 			lipgloss.Left,
 			title,
 			"Loading...",
-		)
+		), 0
 	}
 
 	failures := make([]string, 0)
@@ -475,6 +482,7 @@ func (sidebar *Model) renderChecks() string {
 
 	// Build a set of reported check names to compare against required checks
 	reportedChecks := make(map[string]bool)
+	selectedCheck := ""
 
 	for _, node := range lastCommit.Commit.StatusCheckRollup.Contexts.Nodes {
 		var category CheckCategory
@@ -488,6 +496,10 @@ func (sidebar *Model) renderChecks() string {
 			checkName = string(checkRun.Name)
 			name := renderCheckRunName(checkRun)
 			check = lipgloss.JoinHorizontal(lipgloss.Top, renderedStatus, " ", name)
+			if sidebar.isSelectedCheckRun(checkRun) {
+				check = sidebar.renderSelectedCheck(check)
+				selectedCheck = check
+			}
 		case "StatusContext":
 			statusContext := node.StatusContext
 			var status string
@@ -540,7 +552,7 @@ func (sidebar *Model) renderChecks() string {
 				PaddingLeft(2).
 				Width(sidebar.getIndentedContentWidth()).
 				Render("No checks to display..."),
-		)
+		), 0
 	}
 
 	parts := make([]string, 0)
@@ -571,12 +583,20 @@ func (sidebar *Model) renderChecks() string {
 	parts = append(parts, waiting...)
 	parts = append(parts, rest...)
 
+	cursorLine := lipgloss.Height(title)
+	for _, part := range parts {
+		if selectedCheck != "" && part == selectedCheck {
+			break
+		}
+		cursorLine += lipgloss.Height(part)
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		title,
 		lipgloss.NewStyle().PaddingLeft(2).Width(sidebar.getIndentedContentWidth()).Render(
 			lipgloss.JoinVertical(lipgloss.Left, parts...)),
-	)
+	), cursorLine
 }
 
 type checksStats struct {
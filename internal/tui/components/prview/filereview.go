@@ -0,0 +1,429 @@
+package prview
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+const filesTabIndex = 4
+
+type filesMode int
+
+const (
+	filesModeNone filesMode = iota
+	filesModeList
+	filesModePatch
+)
+
+// filesState holds the navigation state of the "Files Changed" tab.
+// It's kept per PR and reset whenever the viewed PR changes.
+type filesState struct {
+	prUrl          string
+	mode           filesMode
+	fileCursor     int
+	files          []data.PullRequestFile
+	isLoading      bool
+	err            error
+	lines          []data.DiffLine
+	lineCursor     int
+	selectionStart int
+	addToReview    bool
+}
+
+type PullRequestFilesFetchedMsg struct {
+	PrUrl string
+	Files []data.PullRequestFile
+	Err   error
+}
+
+func (m *Model) IsReviewingFiles() bool {
+	return m.hasData() && m.files.mode != filesModeNone &&
+		m.files.prUrl == m.pr.Data.Primary.Url
+}
+
+// StartReviewingFiles switches to the "Files Changed" tab and lets the user
+// navigate the changed files and their patches
+func (m *Model) StartReviewingFiles() tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	m.carousel.SetCursor(filesTabIndex)
+	if m.files.prUrl == m.pr.Data.Primary.Url && m.files.files != nil {
+		m.files.mode = filesModeList
+		return m.FetchShownAnnotations()
+	}
+
+	m.files = filesState{
+		prUrl:          m.pr.Data.Primary.Url,
+		mode:           filesModeList,
+		isLoading:      true,
+		selectionStart: -1,
+	}
+
+	url := m.pr.Data.Primary.Url
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	number := m.pr.Data.Primary.GetNumber()
+	host := data.HostOf(url)
+	return tea.Batch(func() tea.Msg {
+		files, err := data.FetchPullRequestFiles(repo, number, host)
+		return PullRequestFilesFetchedMsg{PrUrl: url, Files: files, Err: err}
+	}, m.FetchShownAnnotations())
+}
+
+func (m *Model) stopReviewingFiles() {
+	m.files.mode = filesModeNone
+}
+
+func (m *Model) onFilesFetched(msg PullRequestFilesFetchedMsg) {
+	if msg.PrUrl != m.files.prUrl {
+		return
+	}
+	m.files.isLoading = false
+	m.files.err = msg.Err
+	m.files.files = msg.Files
+	m.files.fileCursor = 0
+}
+
+func (m *Model) updateFiles(msg tea.KeyMsg) tea.Cmd {
+	switch m.files.mode {
+	case filesModeList:
+		switch {
+		case key.Matches(msg, keys.FileReviewKeys.Down):
+			m.files.fileCursor = min(m.files.fileCursor+1, max(0, len(m.files.files)-1))
+		case key.Matches(msg, keys.FileReviewKeys.Up):
+			m.files.fileCursor = max(m.files.fileCursor-1, 0)
+		case key.Matches(msg, keys.FileReviewKeys.Open):
+			m.openSelectedFile()
+		case key.Matches(msg, keys.FileReviewKeys.Back):
+			m.stopReviewingFiles()
+		}
+
+	case filesModePatch:
+		switch {
+		case key.Matches(msg, keys.FileReviewKeys.Down):
+			m.moveLineCursor(1)
+		case key.Matches(msg, keys.FileReviewKeys.Up):
+			m.moveLineCursor(-1)
+		case key.Matches(msg, keys.FileReviewKeys.SelectRange):
+			if m.files.selectionStart == -1 {
+				m.files.selectionStart = m.files.lineCursor
+			} else {
+				m.files.selectionStart = -1
+			}
+		case key.Matches(msg, keys.FileReviewKeys.Comment):
+			return m.enterReviewComment(false)
+		case key.Matches(msg, keys.FileReviewKeys.AddToReview):
+			return m.enterReviewComment(true)
+		case key.Matches(msg, keys.FileReviewKeys.Back):
+			if m.files.selectionStart != -1 {
+				m.files.selectionStart = -1
+			} else {
+				m.files.mode = filesModeList
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Model) openSelectedFile() {
+	if m.files.fileCursor >= len(m.files.files) {
+		return
+	}
+
+	file := m.files.files[m.files.fileCursor]
+	m.files.lines = data.ParsePatch(file.Patch)
+	m.files.selectionStart = -1
+	m.files.lineCursor = 0
+	m.files.mode = filesModePatch
+	m.moveLineCursor(0)
+}
+
+// moveLineCursor moves the cursor by delta lines, skipping hunk headers since
+// they can't be commented on
+func (m *Model) moveLineCursor(delta int) {
+	lines := m.files.lines
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+
+	cursor := m.files.lineCursor + delta
+	for cursor >= 0 && cursor < len(lines) && !lines[cursor].IsCommentable() {
+		cursor += step
+	}
+	if cursor < 0 || cursor >= len(lines) {
+		return
+	}
+	m.files.lineCursor = cursor
+}
+
+// selectedRange returns the indices of the first and last selected lines of
+// the patch. A comment can't span hunks, so the range is clamped to the hunk
+// the selection started in.
+func (m *Model) selectedRange() (int, int) {
+	if m.files.selectionStart == -1 {
+		return m.files.lineCursor, m.files.lineCursor
+	}
+
+	anchor := m.files.selectionStart
+	start := min(anchor, m.files.lineCursor)
+	end := max(anchor, m.files.lineCursor)
+	first, last := anchor, anchor
+	for first > start && m.files.lines[first-1].IsCommentable() {
+		first--
+	}
+	for last < end && m.files.lines[last+1].IsCommentable() {
+		last++
+	}
+	return first, last
+}
+
+// selectedLines returns the first and last selected lines of the patch
+func (m *Model) selectedLines() (data.DiffLine, data.DiffLine) {
+	start, end := m.selectedRange()
+	return m.files.lines[start], m.files.lines[end]
+}
+
+func (m *Model) enterReviewComment(addToReview bool) tea.Cmd {
+	if len(m.files.lines) == 0 {
+		return nil
+	}
+
+	prompt := constants.ReviewCommentPrompt
+	if addToReview {
+		prompt = constants.PendingReviewCommentPrompt
+	}
+	m.files.addToReview = addToReview
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeReviewComment,
+		Prompt:                           prompt,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+}
+
+func (m *Model) submitReviewComment(sid tasks.SectionIdentifier, body string) tea.Cmd {
+	if len(strings.TrimSpace(body)) == 0 || m.files.mode != filesModePatch {
+		return nil
+	}
+
+	if !m.pr.Data.IsEnriched {
+		m.ctx.Error = fmt.Errorf("PR #%d is still loading, try again", m.pr.Data.Primary.Number)
+		return nil
+	}
+
+	first, last := m.selectedLines()
+	thread := data.NewReviewThread{
+		Path: m.files.files[m.files.fileCursor].Filename,
+		Body: body,
+		Line: last.Line(),
+		Side: last.Side(),
+	}
+	if first != last {
+		thread.StartLine = first.Line()
+		thread.StartSide = first.Side()
+	}
+	m.files.selectionStart = -1
+
+	return tasks.AddPRReviewComment(
+		m.ctx,
+		sid,
+		m.pr.Data.Primary.Url,
+		m.pr.Data.Enriched.Id,
+		m.pr.Data.Primary.Number,
+		thread,
+		m.files.addToReview,
+	)
+}
+
+// FilesCursorLine returns the line of the rendered view the files cursor is
+// on, so the sidebar can keep it visible
+func (m *Model) FilesCursorLine() int {
+	if !m.IsReviewingFiles() {
+		return 0
+	}
+
+	line := lipgloss.Height(m.viewHeader())
+	switch m.files.mode {
+	case filesModeList:
+		for i := 0; i < m.files.fileCursor && i < len(m.files.files); i++ {
+			line += lipgloss.Height(m.renderReviewFile(i))
+		}
+	case filesModePatch:
+		line += 2 + m.files.lineCursor
+	}
+
+	return line
+}
+
+func (m *Model) renderFilesTab() string {
+	if !m.IsReviewingFiles() {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.renderChangedFiles(),
+			"",
+			m.ctx.Styles.Common.FaintTextStyle.Render(
+				fmt.Sprintf("Press %s to review the changed files", keys.PRKeys.ReviewFiles.Help().Key),
+			),
+		)
+	}
+
+	if m.files.isLoading {
+		return m.ctx.Styles.Common.FaintTextStyle.Render("Loading...")
+	}
+
+	if m.files.err != nil {
+		return lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(
+			fmt.Sprintf("Failed fetching files: %v", m.files.err))
+	}
+
+	body := strings.Builder{}
+	if m.files.mode == filesModePatch {
+		body.WriteString(m.renderPatch())
+	} else {
+		rows := make([]string, 0, len(m.files.files))
+		for i := range m.files.files {
+			rows = append(rows, m.renderReviewFile(i))
+		}
+		body.WriteString(lipgloss.JoinVertical(lipgloss.Left, rows...))
+	}
+
+	if m.editor.Mode() == cmpcontroller.ModeReviewComment {
+		body.WriteString("\n")
+		body.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return body.String()
+}
+
+func (m *Model) renderReviewFile(i int) string {
+	file := m.files.files[i]
+	rendered := m.renderFile(data.ChangedFile{
+		Additions:  file.Additions,
+		Deletions:  file.Deletions,
+		Path:       file.Filename,
+		ChangeType: restFileStatusToChangeType(file.Status),
+	})
+	if i == m.files.fileCursor {
+		return lipgloss.NewStyle().
+			Background(m.ctx.Theme.SelectedBackground).
+			Width(m.getIndentedContentWidth()).
+			Render(rendered)
+	}
+	return rendered
+}
+
+// restFileStatusToChangeType maps the file status of the REST API to the
+// change type used by the GraphQL API
+func restFileStatusToChangeType(status string) string {
+	switch status {
+	case "added":
+		return "ADDED"
+	case "removed":
+		return "DELETED"
+	case "renamed":
+		return "RENAMED"
+	case "copied":
+		return "COPIED"
+	case "changed":
+		return "CHANGED"
+	default:
+		return "MODIFIED"
+	}
+}
+
+func (m *Model) renderPatch() string {
+	file := m.files.files[m.files.fileCursor]
+	width := m.getIndentedContentWidth()
+	header := m.ctx.Styles.Common.MainTextStyle.Bold(true).Render(file.Filename)
+
+	if len(m.files.lines) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, header, "",
+			m.ctx.Styles.Common.FaintTextStyle.Render("No patch available for this file."))
+	}
+
+	threads := make(map[int]bool)
+	if m.pr.Data.IsEnriched {
+		for _, thread := range m.pr.Data.Enriched.ReviewThreads.Nodes {
+			if thread.Path == file.Filename {
+				threads[thread.Line] = true
+			}
+		}
+	}
+
+	annotated := m.annotatedLines(file.Filename)
+
+	selStart, selEnd := m.selectedRange()
+
+	faint := m.ctx.Styles.Common.FaintTextStyle
+	rows := make([]string, 0, len(m.files.lines)+2)
+	rows = append(rows, header, "")
+	for i, line := range m.files.lines {
+		style := lipgloss.NewStyle()
+		prefix := " "
+		switch line.Type {
+		case data.DiffLineHunk:
+			rows = append(rows, faint.Render(ansi.Truncate(line.Content, width, constants.Ellipsis)))
+			continue
+		case data.DiffLineAddition:
+			style = style.Foreground(m.ctx.Theme.SuccessText)
+			prefix = "+"
+		case data.DiffLineDeletion:
+			style = style.Foreground(m.ctx.Theme.ErrorText)
+			prefix = "-"
+		}
+
+		gutter := fmt.Sprintf("%4s %4s ", lineNumber(line.OldLine), lineNumber(line.NewLine))
+		marker := " "
+		if line.Type != data.DiffLineDeletion {
+			if threads[line.NewLine] {
+				marker = m.ctx.Styles.Common.CommentGlyph
+			} else if level, ok := annotated[line.NewLine]; ok {
+				marker = m.renderAnnotationGlyph(level)
+			}
+		}
+
+		content := strings.ReplaceAll(line.Content, "\t", "    ")
+		content = ansi.Truncate(
+			prefix+content,
+			max(0, width-lipgloss.Width(gutter)-lipgloss.Width(marker)-1),
+			constants.Ellipsis,
+		)
+		row := lipgloss.JoinHorizontal(lipgloss.Top, faint.Render(gutter), marker, " ", style.Render(content))
+
+		if i >= selStart && i <= selEnd {
+			row = lipgloss.NewStyle().
+				Background(m.ctx.Theme.SelectedBackground).
+				Width(width).
+				Render(row)
+		}
+		rows = append(rows, row)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+func lineNumber(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprint(n)
+}
@@ -0,0 +1,120 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func newFileReviewTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModel(t, &data.PullRequestData{
+		Number: 1,
+		Url:    "https://github.com/owner/repo/pull/1",
+	}, nil, nil)
+	m.files = filesState{
+		prUrl:          m.pr.Data.Primary.Url,
+		mode:           filesModeList,
+		selectionStart: -1,
+	}
+	m.onFilesFetched(PullRequestFilesFetchedMsg{
+		PrUrl: m.pr.Data.Primary.Url,
+		Files: []data.PullRequestFile{
+			{Filename: "README.md", Status: "modified", Patch: "@@ -1 +1 @@\n-old\n+new"},
+			{
+				Filename: "main.go",
+				Status:   "modified",
+				Patch:    "@@ -1,2 +1,3 @@\n package main\n+\n+func main() {}",
+			},
+		},
+	})
+	return m
+}
+
+func pressKey(m *Model, k string) {
+	var msg tea.KeyPressMsg
+	switch k {
+	case "enter":
+		msg = tea.KeyPressMsg{Code: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyPressMsg{Code: tea.KeyEscape}
+	default:
+		msg = tea.KeyPressMsg{Code: rune(k[0]), Text: k}
+	}
+	m.updateFiles(msg)
+}
+
+func TestFileReviewNavigation(t *testing.T) {
+	m := newFileReviewTestModel(t)
+	require.True(t, m.IsReviewingFiles())
+
+	pressKey(&m, "j")
+	require.Equal(t, 1, m.files.fileCursor)
+	pressKey(&m, "j")
+	require.Equal(t, 1, m.files.fileCursor, "cursor should stop at the last file")
+
+	pressKey(&m, "enter")
+	require.Equal(t, filesModePatch, m.files.mode)
+	require.Equal(t, 1, m.files.lineCursor, "cursor should skip the hunk header")
+
+	pressKey(&m, "k")
+	require.Equal(t, 1, m.files.lineCursor, "cursor should not move onto the hunk header")
+
+	pressKey(&m, "esc")
+	require.Equal(t, filesModeList, m.files.mode)
+	pressKey(&m, "esc")
+	require.False(t, m.IsReviewingFiles())
+}
+
+func TestFileReviewRangeSelection(t *testing.T) {
+	m := newFileReviewTestModel(t)
+	pressKey(&m, "j")
+	pressKey(&m, "enter")
+
+	pressKey(&m, "v")
+	pressKey(&m, "j")
+	pressKey(&m, "j")
+
+	first, last := m.selectedLines()
+	require.Equal(t, 1, first.Line())
+	require.Equal(t, 3, last.Line())
+	require.Equal(t, data.DiffSideRight, last.Side())
+
+	pressKey(&m, "esc")
+	require.Equal(t, -1, m.files.selectionStart)
+	require.Equal(t, filesModePatch, m.files.mode)
+}
+
+func TestFileReviewRangeSelectionStaysInHunk(t *testing.T) {
+	m := newFileReviewTestModel(t)
+	m.onFilesFetched(PullRequestFilesFetchedMsg{
+		PrUrl: m.pr.Data.Primary.Url,
+		Files: []data.PullRequestFile{{
+			Filename: "main.go",
+			Status:   "modified",
+			Patch:    "@@ -1,2 +1,2 @@\n a\n-b\n+c\n@@ -10,2 +10,2 @@\n x\n+y",
+		}},
+	})
+	pressKey(&m, "enter")
+	pressKey(&m, "j")
+
+	pressKey(&m, "v")
+	pressKey(&m, "j")
+	pressKey(&m, "j")
+	require.Equal(t, 5, m.files.lineCursor, "cursor should be in the second hunk")
+
+	first, last := m.selectedLines()
+	require.Equal(t, data.DiffSideLeft, first.Side())
+	require.Equal(t, 2, first.Line())
+	require.Equal(t, data.DiffSideRight, last.Side())
+	require.Equal(t, 2, last.Line(), "the range should end in the hunk it started in")
+}
+
+func TestFileReviewIgnoresOtherPRs(t *testing.T) {
+	m := newFileReviewTestModel(t)
+	m.pr.Data.Primary.Url = "https://github.com/owner/repo/pull/2"
+	require.False(t, m.IsReviewingFiles())
+}
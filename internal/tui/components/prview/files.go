@@ -79,7 +79,12 @@ func (m *Model) renderFile(file data.ChangedFile) string {
 
 	path := file.Path
 	remaining := m.getIndentedContentWidth() - lipgloss.Width(prefix)
-	if len(path) > remaining {
+	annotations := m.renderAnnotationCounts(file.Path)
+	if annotations != "" {
+		annotations = " " + annotations
+		remaining -= lipgloss.Width(annotations)
+	}
+	if remaining > 0 && len(path) > remaining {
 		path = lipgloss.JoinVertical(lipgloss.Left, path[0:remaining], " "+path[remaining:])
 	}
 
@@ -87,6 +92,7 @@ func (m *Model) renderFile(file data.ChangedFile) string {
 		lipgloss.Top,
 		prefix,
 		path,
+		annotations,
 	)
 }
 
@@ -0,0 +1,216 @@
+package prview
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// mergeState holds the options picked in the merge composer
+type mergeState struct {
+	prUrl        string
+	isChoosing   bool
+	method       config.MergeMethod
+	deleteBranch bool
+	headline     string
+	body         string
+}
+
+func (m *Model) IsChoosingMergeOptions() bool {
+	return m.hasData() && m.merge.isChoosing && m.merge.prUrl == m.pr.Data.Primary.Url
+}
+
+// SetIsMerging opens or closes the merge composer. The merge method starts as
+// the one configured for the repo of the PR.
+func (m *Model) SetIsMerging(isMerging bool) tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	if !isMerging {
+		m.merge.isChoosing = false
+		if m.editor.Mode() == cmpcontroller.ModeMergeMessage {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.merge = mergeState{
+		prUrl:      m.pr.Data.Primary.Url,
+		isChoosing: true,
+		method:     m.ctx.Config.GetMergeMethod(m.pr.Data.Primary.GetRepoNameWithOwner()),
+	}
+	return nil
+}
+
+func (m *Model) mergeOptions() data.MergeOptions {
+	return data.MergeOptions{
+		Method:         m.merge.method,
+		CommitHeadline: m.merge.headline,
+		CommitBody:     m.merge.body,
+		DeleteBranch:   m.merge.deleteBranch,
+	}
+}
+
+func (m *Model) updateMerge(msg tea.KeyMsg) tea.Cmd {
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+
+	switch {
+	case key.Matches(msg, keys.MergeKeys.Merge):
+		m.merge.method = config.MergeMethodMerge
+	case key.Matches(msg, keys.MergeKeys.Squash):
+		m.merge.method = config.MergeMethodSquash
+	case key.Matches(msg, keys.MergeKeys.Rebase):
+		m.merge.method = config.MergeMethodRebase
+	case key.Matches(msg, keys.MergeKeys.DeleteBranch):
+		m.merge.deleteBranch = !m.merge.deleteBranch
+	case key.Matches(msg, keys.MergeKeys.EditMessage):
+		return m.enterMergeMessage()
+	case key.Matches(msg, keys.MergeKeys.Submit):
+		m.merge.isChoosing = false
+		return tasks.MergePR(m.ctx, sid, m.pr.Data.Primary, m.mergeOptions())
+	case key.Matches(msg, keys.MergeKeys.AutoMerge):
+		m.merge.isChoosing = false
+		return tasks.EnablePRAutoMerge(m.ctx, sid, m.pr.Data.Primary, m.mergeOptions())
+	case key.Matches(msg, keys.MergeKeys.Enqueue):
+		if m.pr.Data.Primary.IsInMergeQueue {
+			m.ctx.Error = fmt.Errorf("PR #%d is already in the merge queue", m.pr.Data.Primary.Number)
+			return nil
+		}
+		m.merge.isChoosing = false
+		return tasks.EnqueuePR(m.ctx, sid, m.pr.Data.Primary)
+	case key.Matches(msg, keys.MergeKeys.Cancel):
+		m.merge.isChoosing = false
+	}
+
+	return nil
+}
+
+func (m *Model) enterMergeMessage() tea.Cmd {
+	if m.merge.method == config.MergeMethodRebase {
+		m.ctx.Error = fmt.Errorf("rebasing keeps the commits of the PR, there's no message to edit")
+		return nil
+	}
+
+	initialValue := m.merge.headline
+	if initialValue == "" {
+		initialValue = m.defaultMergeHeadline()
+	}
+	if m.merge.body != "" {
+		initialValue += "\n\n" + m.merge.body
+	}
+
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:         cmpcontroller.ModeMergeMessage,
+		Prompt:       constants.MergeMessagePrompt,
+		InitialValue: initialValue,
+		Repo:         m.repoRef(),
+	})
+}
+
+// defaultMergeHeadline mirrors the headline GitHub uses when none is given
+func (m *Model) defaultMergeHeadline() string {
+	pr := m.pr.Data.Primary
+	if m.merge.method == config.MergeMethodSquash {
+		return fmt.Sprintf("%s (#%d)", pr.Title, pr.Number)
+	}
+	return fmt.Sprintf("Merge pull request #%d from %s", pr.Number, pr.HeadRefName)
+}
+
+// setMergeMessage splits the edited message into the commit headline, which
+// is its first line, and the commit body
+func (m *Model) setMergeMessage(message string) {
+	headline, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	m.merge.headline = strings.TrimSpace(headline)
+	m.merge.body = strings.TrimSpace(body)
+}
+
+func (m *Model) renderMergeComposer() string {
+	if !m.IsChoosingMergeOptions() {
+		return ""
+	}
+
+	faint := m.ctx.Styles.Common.FaintTextStyle
+	keyStyle := lipgloss.NewStyle().
+		Background(m.ctx.Theme.SelectedBackground).
+		Foreground(m.ctx.Theme.PrimaryText).
+		Padding(0, 1)
+	renderOption := func(binding key.Binding, isSelected bool) string {
+		desc := binding.Help().Desc
+		if isSelected {
+			desc = m.ctx.Styles.Common.MainTextStyle.Bold(true).Underline(true).Render(desc)
+		}
+		return lipgloss.JoinHorizontal(lipgloss.Top, keyStyle.Render(binding.Help().Key), " ", desc)
+	}
+
+	methods := strings.Join([]string{
+		renderOption(keys.MergeKeys.Merge, m.merge.method == config.MergeMethodMerge),
+		renderOption(keys.MergeKeys.Squash, m.merge.method == config.MergeMethodSquash),
+		renderOption(keys.MergeKeys.Rebase, m.merge.method == config.MergeMethodRebase),
+	}, "  ")
+
+	// Auto-merge and the merge queue can't delete the branch, the repo's
+	// auto-delete setting applies to them instead
+	deleteBranch := "no"
+	if m.merge.deleteBranch {
+		deleteBranch = "yes, when merging right away"
+	}
+	settings := strings.Join([]string{
+		renderOption(keys.MergeKeys.DeleteBranch, false) + faint.Render(": "+deleteBranch),
+		renderOption(keys.MergeKeys.EditMessage, false),
+	}, "  ")
+
+	headline := m.merge.headline
+	if headline == "" {
+		headline = "default"
+	}
+	message := faint.Render("Commit message: " + headline)
+	if m.merge.method == config.MergeMethodRebase {
+		message = faint.Render("Commits are rebased onto the base branch")
+	}
+
+	pr := m.pr.Data.Primary
+	status := "Merge state: unknown"
+	if pr.MergeStateStatus != "" {
+		status = fmt.Sprintf("Merge state: %s", strings.ToLower(string(pr.MergeStateStatus)))
+	}
+	if pr.IsInMergeQueue {
+		status += ", in the merge queue"
+	}
+
+	actions := strings.Join([]string{
+		renderOption(keys.MergeKeys.Submit, false),
+		renderOption(keys.MergeKeys.AutoMerge, false),
+		renderOption(keys.MergeKeys.Enqueue, false),
+		renderOption(keys.MergeKeys.Cancel, false),
+	}, "  ")
+
+	parts := []string{
+		m.ctx.Styles.Common.MainTextStyle.Bold(true).Render(
+			fmt.Sprintf("Merge PR #%d", pr.Number)),
+		faint.Render(status),
+		"",
+		methods,
+		settings,
+		message,
+		"",
+		actions,
+	}
+	if m.editor.Mode() == cmpcontroller.ModeMergeMessage {
+		parts = append(parts, "", m.editor.View())
+	}
+
+	return m.ctx.Styles.Sidebar.InputBox.Render(
+		lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
@@ -0,0 +1,58 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func newMergeTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModel(t, &data.PullRequestData{
+		Number:      1,
+		Title:       "Add merge composer",
+		HeadRefName: "merge-composer",
+		Url:         "https://github.com/owner/repo/pull/1",
+		Repository:  data.Repository{NameWithOwner: "owner/repo"},
+	}, nil, nil)
+	m.ctx.Config.MergeMethods = map[string]config.MergeMethod{"owner/*": config.MergeMethodSquash}
+	m.SetIsMerging(true)
+	return m
+}
+
+func TestMergeStartsWithRepoMethod(t *testing.T) {
+	m := newMergeTestModel(t)
+	require.True(t, m.IsChoosingMergeOptions())
+	require.Equal(t, config.MergeMethodSquash, m.merge.method)
+	require.Equal(t, "Add merge composer (#1)", m.defaultMergeHeadline())
+}
+
+func TestMergePickOptions(t *testing.T) {
+	m := newMergeTestModel(t)
+
+	m.updateMerge(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	require.Equal(t, config.MergeMethodRebase, m.merge.method)
+
+	m.updateMerge(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	require.True(t, m.mergeOptions().DeleteBranch)
+	require.Contains(t, ansi.Strip(m.renderMergeComposer()), "yes, when merging right away")
+
+	m.updateMerge(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	require.Error(t, m.ctx.Error, "rebase merges have no message to edit")
+
+	m.updateMerge(tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.False(t, m.IsChoosingMergeOptions())
+}
+
+func TestSetMergeMessage(t *testing.T) {
+	m := newMergeTestModel(t)
+
+	m.setMergeMessage("  Ship it (#1)\n\nLonger description\nacross lines\n")
+	require.Equal(t, "Ship it (#1)", m.merge.headline)
+	require.Equal(t, "Longer description\nacross lines", m.merge.body)
+}
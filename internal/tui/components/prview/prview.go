@@ -40,9 +40,16 @@ type Model struct {
 	carousel        carousel.Model
 	editor          cmpcontroller.Controller
 	summaryViewMore bool
+	files           filesState
+	review          reviewState
+	threads         threadsState
+	checks          checksState
+	runAnnotations  annotationsState
+	merge           mergeState
+	selectedPrs     []data.PullRequestData
 }
 
-var tabs = []string{" Overview", " Activity", " Commits", " Checks", " Files Changed"}
+var tabs = []string{" Overview", " Activity", " Commits", " Checks", " Files Changed", " Threads"}
 
 func NewModel(ctx *context.ProgramContext) Model {
 	c := carousel.New(
@@ -73,6 +80,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 		sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
 
+		if cmd, ok := m.submitSelection(sid, mode, value); ok {
+			return m, cmd
+		}
+
 		switch mode {
 		case cmpcontroller.ModeComment:
 			if len(strings.TrimSpace(value)) != 0 {
@@ -107,6 +118,19 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				return m, m.label(labels)
 			}
 			return m, nil
+
+		case cmpcontroller.ModeReviewComment:
+			return m, m.submitReviewComment(sid, value)
+
+		case cmpcontroller.ModeReview:
+			return m, m.submitReview(sid, value)
+
+		case cmpcontroller.ModeThreadReply:
+			return m, m.submitThreadReply(sid, value)
+
+		case cmpcontroller.ModeMergeMessage:
+			m.setMergeMessage(value)
+			return m, nil
 		}
 	}
 
@@ -114,12 +138,49 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, cmd
 	}
 
+	switch msg := msg.(type) {
+	case PullRequestFilesFetchedMsg:
+		m.onFilesFetched(msg)
+		return m, nil
+	case PendingReviewFetchedMsg:
+		m.onPendingReviewFetched(msg)
+		return m, nil
+	case JobLogFetchedMsg:
+		m.onJobLogFetched(msg)
+		return m, nil
+	case CheckRunAnnotationsFetchedMsg:
+		m.onAnnotationsFetched(msg)
+		return m, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.IsChoosingMergeOptions() {
+		return m, m.updateMerge(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.IsChoosingReviewEvent() {
+		return m, m.updateReviewEvent(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.IsReviewingFiles() {
+		return m, m.updateFiles(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.IsNavigatingThreads() {
+		return m, m.updateThreads(keyMsg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.IsNavigatingChecks() {
+		return m, m.updateChecks(keyMsg)
+	}
+
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
 		switch {
 		case key.Matches(keyMsg, keys.PRKeys.PrevSidebarTab):
 			m.carousel.MoveLeft()
+			return m, tea.Batch(cmd, m.FetchShownAnnotations())
 		case key.Matches(keyMsg, keys.PRKeys.NextSidebarTab):
 			m.carousel.MoveRight()
+			return m, tea.Batch(cmd, m.FetchShownAnnotations())
 		}
 	}
 
@@ -139,12 +200,26 @@ func (m Model) View() string {
 		body.WriteString(m.renderActivity())
 	case tabs[2]:
 		body.WriteString(m.renderCommits())
-	case tabs[3]:
+	case tabs[checksTabIndex]:
 		body.WriteString(m.renderChecksOverview())
 		body.WriteString("\n\n")
 		body.WriteString(m.renderChecks())
-	case tabs[4]:
-		body.WriteString(m.renderChangedFiles())
+		if hint := m.renderChecksHint(); hint != "" {
+			body.WriteString("\n\n")
+			body.WriteString(hint)
+		}
+		if jobLog := m.renderJobLog(); jobLog != "" {
+			body.WriteString("\n\n")
+			body.WriteString(jobLog)
+		}
+		if annotations := m.renderAnnotations(); annotations != "" {
+			body.WriteString("\n\n")
+			body.WriteString(annotations)
+		}
+	case tabs[filesTabIndex]:
+		body.WriteString(m.renderFilesTab())
+	case tabs[threadsTabIndex]:
+		body.WriteString(m.renderThreadsTab())
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left,
@@ -203,7 +278,15 @@ func (m *Model) viewOverviewTab() string {
 	body.WriteString("\n")
 	body.WriteString(m.renderChecksOverview())
 
-	if m.editor.Mode() != cmpcontroller.ModeNone {
+	if composer := m.renderMergeComposer(); composer != "" {
+		body.WriteString(composer)
+	} else if composer := m.renderReviewComposer(); composer != "" {
+		body.WriteString(composer)
+	} else if m.editor.Mode() != cmpcontroller.ModeNone {
+		if note := m.renderSelectionNote(); note != "" {
+			body.WriteString("\n\n")
+			body.WriteString(note)
+		}
 		body.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
 	}
 
@@ -573,6 +656,17 @@ func (m *Model) EnrichCurrRow() tea.Cmd {
 		return nil
 	}
 	url := m.pr.Data.Primary.Url
+	// Rows fetched ahead of the selection are shown right away
+	if d, ok := data.CachedPullRequest(url, m.pr.Data.Primary.UpdatedAt); ok {
+		m.SetEnrichedPR(d)
+		return func() tea.Msg {
+			return EnrichedPrMsg{
+				Id:   m.sectionId,
+				Type: prssection.SectionType,
+				Data: d,
+			}
+		}
+	}
 	return func() tea.Msg {
 		d, err := data.FetchPullRequest(url)
 		return EnrichedPrMsg{
@@ -689,7 +783,7 @@ func (m *Model) SetIsAssigning(isAssigning bool) tea.Cmd {
 	}
 
 	initialValue := ""
-	if !m.userAssignedToPr(m.ctx.User) {
+	if len(m.selectedPrs) > 0 || !m.userAssignedToPr(m.ctx.User) {
 		initialValue = m.ctx.User
 	}
 
@@ -792,17 +886,25 @@ func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
 		return nil
 	}
 
-	labels := make([]string, 0, len(m.pr.Data.Primary.Labels.Nodes)+1)
-	for _, label := range m.pr.Data.Primary.Labels.Nodes {
-		labels = append(labels, label.Name)
+	// The selected PRs have different labels so only adding labels to all of
+	// them is supported
+	prompt := constants.AddLabelsPrompt
+	initialValue := ""
+	if len(m.selectedPrs) == 0 {
+		labels := make([]string, 0, len(m.pr.Data.Primary.Labels.Nodes)+1)
+		for _, label := range m.pr.Data.Primary.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
+		labels = append(labels, "")
+		prompt = constants.LabelPrompt
+		initialValue = strings.Join(labels, ", ")
 	}
-	labels = append(labels, "")
 
 	m.editor.SetAutocompleteSource(&fuzzyselect.LabelSource{})
 	cmd := m.editor.Enter(cmpcontroller.EnterOptions{
 		Mode:                             cmpcontroller.ModeLabel,
-		Prompt:                           constants.LabelPrompt,
-		InitialValue:                     strings.Join(labels, ", "),
+		Prompt:                           prompt,
+		InitialValue:                     initialValue,
 		Repo:                             m.repoRef(),
 		EnterFetch:                       cmpcontroller.FetchSilent,
 		HideAutocompleteWhenContextEmpty: false,
@@ -818,6 +920,7 @@ func (m *Model) repoRef() cmpcontroller.RepoRef {
 		NameWithOwner: m.pr.Data.Primary.GetRepoNameWithOwner(),
 		Owner:         owner,
 		Name:          repo,
+		Host:          data.HostOf(m.pr.Data.Primary.Url),
 	}
 }
 
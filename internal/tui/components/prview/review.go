@@ -0,0 +1,207 @@
+package prview
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// reviewState holds the state of the review composer: first the user picks
+// how to submit the review and then writes its body in the editor.
+type reviewState struct {
+	prUrl          string
+	isChoosing     bool
+	event          data.ReviewEvent
+	pending        *data.PendingReview
+	pendingLoading bool
+}
+
+type PendingReviewFetchedMsg struct {
+	PrUrl   string
+	Pending *data.PendingReview
+	Err     error
+}
+
+func (m *Model) IsChoosingReviewEvent() bool {
+	return m.hasData() && m.review.isChoosing && m.review.prUrl == m.pr.Data.Primary.Url
+}
+
+// SetIsReviewing opens or closes the review composer. The viewer's pending
+// review is fetched so the composer can show how many inline comments will be
+// submitted with the review.
+func (m *Model) SetIsReviewing(isReviewing bool) tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	if !isReviewing {
+		m.review.isChoosing = false
+		if m.editor.Mode() == cmpcontroller.ModeReview {
+			m.editor.Exit()
+		}
+		return nil
+	}
+
+	m.review = reviewState{
+		prUrl:      m.pr.Data.Primary.Url,
+		isChoosing: true,
+	}
+
+	if !m.pr.Data.IsEnriched {
+		return nil
+	}
+
+	m.review.pendingLoading = true
+	url := m.pr.Data.Primary.Url
+	prId := m.pr.Data.Enriched.Id
+	return func() tea.Msg {
+		pending, err := data.FetchPendingReview(prId, data.HostOf(url))
+		return PendingReviewFetchedMsg{PrUrl: url, Pending: pending, Err: err}
+	}
+}
+
+func (m *Model) onPendingReviewFetched(msg PendingReviewFetchedMsg) {
+	if msg.PrUrl != m.review.prUrl {
+		return
+	}
+	m.review.pendingLoading = false
+	if msg.Err != nil {
+		m.ctx.Error = msg.Err
+		return
+	}
+	m.review.pending = msg.Pending
+}
+
+func (m *Model) updateReviewEvent(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.ReviewEventKeys.Approve):
+		return m.enterReview(data.ReviewEventApprove)
+	case key.Matches(msg, keys.ReviewEventKeys.RequestChanges):
+		return m.enterReview(data.ReviewEventRequestChanges)
+	case key.Matches(msg, keys.ReviewEventKeys.Comment):
+		return m.enterReview(data.ReviewEventComment)
+	case key.Matches(msg, keys.ReviewEventKeys.Cancel):
+		m.review.isChoosing = false
+	}
+	return nil
+}
+
+func (m *Model) enterReview(event data.ReviewEvent) tea.Cmd {
+	m.review.isChoosing = false
+	m.review.event = event
+
+	prompt := constants.CommentReviewPrompt
+	initialValue := ""
+	switch event {
+	case data.ReviewEventApprove:
+		prompt = constants.ApprovalPrompt
+		initialValue = m.ctx.Config.Defaults.PrApproveComment
+	case data.ReviewEventRequestChanges:
+		prompt = constants.RequestChangesReviewPrompt
+	}
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeReview,
+		Prompt:                           prompt,
+		InitialValue:                     initialValue,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+}
+
+func (m *Model) submitReview(sid tasks.SectionIdentifier, body string) tea.Cmd {
+	if m.review.prUrl != m.pr.Data.Primary.Url {
+		return nil
+	}
+
+	if !m.pr.Data.IsEnriched {
+		m.ctx.Error = fmt.Errorf("PR #%d is still loading, try again", m.pr.Data.Primary.Number)
+		return nil
+	}
+
+	body = strings.TrimSpace(body)
+	hasPendingComments := m.review.pending != nil && m.review.pending.Comments.TotalCount > 0
+	if body == "" && !hasPendingComments && m.review.event != data.ReviewEventApprove {
+		m.ctx.Error = fmt.Errorf("a review that isn't an approval needs a comment")
+		return nil
+	}
+
+	return tasks.SubmitPRReview(
+		m.ctx,
+		sid,
+		m.pr.Data.Primary.Url,
+		m.pr.Data.Enriched.Id,
+		m.pr.Data.Primary.Number,
+		m.review.event,
+		body,
+	)
+}
+
+func (m *Model) renderReviewComposer() string {
+	if !m.IsChoosingReviewEvent() && m.editor.Mode() != cmpcontroller.ModeReview {
+		return ""
+	}
+
+	faint := m.ctx.Styles.Common.FaintTextStyle
+	var pending string
+	switch {
+	case m.review.pendingLoading:
+		pending = faint.Render("Loading pending comments...")
+	case m.review.pending != nil && m.review.pending.Comments.TotalCount > 0:
+		pending = faint.Render(fmt.Sprintf(
+			"%d pending inline comment(s) will be submitted with the review",
+			m.review.pending.Comments.TotalCount,
+		))
+	default:
+		pending = faint.Render("No pending inline comments")
+	}
+
+	if m.editor.Mode() == cmpcontroller.ModeReview {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			pending,
+			m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()),
+		)
+	}
+
+	keyStyle := lipgloss.NewStyle().
+		Background(m.ctx.Theme.SelectedBackground).
+		Foreground(m.ctx.Theme.PrimaryText).
+		Padding(0, 1)
+	options := make([]string, 0, 4)
+	for _, binding := range []key.Binding{
+		keys.ReviewEventKeys.Approve,
+		keys.ReviewEventKeys.RequestChanges,
+		keys.ReviewEventKeys.Comment,
+		keys.ReviewEventKeys.Cancel,
+	} {
+		options = append(options, lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			keyStyle.Render(binding.Help().Key),
+			" ",
+			binding.Help().Desc,
+		))
+	}
+
+	return m.ctx.Styles.Sidebar.InputBox.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.ctx.Styles.Common.MainTextStyle.Bold(true).Render("Submit review as"),
+		"",
+		strings.Join(options, "  "),
+		"",
+		pending,
+	))
+}
@@ -0,0 +1,52 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+func newReviewTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModel(t, &data.PullRequestData{
+		Number: 1,
+		Url:    "https://github.com/owner/repo/pull/1",
+	}, nil, nil)
+	m.review = reviewState{
+		prUrl:      m.pr.Data.Primary.Url,
+		isChoosing: true,
+	}
+	return m
+}
+
+func TestReviewChooseEvent(t *testing.T) {
+	m := newReviewTestModel(t)
+	require.True(t, m.IsChoosingReviewEvent())
+
+	m.updateReviewEvent(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	require.False(t, m.IsChoosingReviewEvent())
+	require.Equal(t, data.ReviewEventRequestChanges, m.review.event)
+	require.Equal(t, cmpcontroller.ModeReview, m.editor.Mode())
+}
+
+func TestReviewCancelChoosingEvent(t *testing.T) {
+	m := newReviewTestModel(t)
+
+	m.updateReviewEvent(tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.False(t, m.IsChoosingReviewEvent())
+	require.Equal(t, cmpcontroller.ModeNone, m.editor.Mode())
+}
+
+func TestSubmitReviewRequiresBody(t *testing.T) {
+	m := newReviewTestModel(t)
+	m.review.event = data.ReviewEventComment
+
+	cmd := m.submitReview(tasks.SectionIdentifier{}, "  ")
+	require.Nil(t, cmd)
+	require.Error(t, m.ctx.Error)
+}
@@ -0,0 +1,85 @@
+package prview
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+)
+
+// SetSelectedPrs sets the PRs selected in the section. While there are any,
+// approving, assigning and labeling run over all of them instead of the
+// viewed PR.
+func (m *Model) SetSelectedPrs(prs []data.PullRequestData) {
+	m.selectedPrs = prs
+}
+
+func (m *Model) isEditingSelection() bool {
+	if len(m.selectedPrs) == 0 {
+		return false
+	}
+
+	switch m.editor.Mode() {
+	case cmpcontroller.ModeApprove, cmpcontroller.ModeAssign, cmpcontroller.ModeLabel:
+		return true
+	}
+	return false
+}
+
+// submitSelection runs the submitted action over the selected PRs and clears
+// the selection of the section. ok is false if the action only runs on the
+// viewed PR.
+func (m *Model) submitSelection(
+	sid tasks.SectionIdentifier,
+	mode cmpcontroller.Mode,
+	value string,
+) (cmd tea.Cmd, ok bool) {
+	prs := m.selectedPrs
+	if len(prs) == 0 {
+		return nil, false
+	}
+
+	rows := make([]data.RowData, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, pr)
+	}
+
+	switch mode {
+	case cmpcontroller.ModeApprove:
+		cmd = tasks.ApprovePRs(m.ctx, sid, rows, strings.TrimSpace(value))
+	case cmpcontroller.ModeAssign:
+		usernames := fuzzyselect.AllWords(value)
+		if len(usernames) == 0 {
+			return nil, true
+		}
+		cmd = tasks.AssignPRs(m.ctx, sid, rows, usernames)
+	case cmpcontroller.ModeLabel:
+		labels := fuzzyselect.CurrentLabels(value)
+		if len(labels) == 0 {
+			return nil, true
+		}
+		cmd = tasks.AddPRLabels(m.ctx, sid, prs, labels)
+	default:
+		return nil, false
+	}
+
+	m.selectedPrs = nil
+	return tea.Batch(cmd, func() tea.Msg {
+		return section.ClearSelectionMsg{SectionId: sid.Id, SectionType: sid.Type}
+	}), true
+}
+
+func (m *Model) renderSelectionNote() string {
+	if !m.isEditingSelection() {
+		return ""
+	}
+
+	return m.ctx.Styles.Common.FaintTextStyle.Render(
+		fmt.Sprintf("Applies to the %d selected PRs", len(m.selectedPrs)))
+}
@@ -0,0 +1,316 @@
+package prview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/glamour/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/cmpcontroller"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fuzzyselect"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const threadsTabIndex = 5
+
+// threadsState holds the navigation state of the "Threads" tab.
+// It's kept per PR and reset whenever the viewed PR changes.
+type threadsState struct {
+	prUrl         string
+	isNavigating  bool
+	cursor        int
+	replyThreadId string
+}
+
+func (m *Model) IsNavigatingThreads() bool {
+	return m.hasData() && m.threads.isNavigating && m.threads.prUrl == m.pr.Data.Primary.Url
+}
+
+// StartNavigatingThreads switches to the "Threads" tab and lets the user pick
+// a review thread to reply to or resolve
+func (m *Model) StartNavigatingThreads() tea.Cmd {
+	if !m.hasData() {
+		return nil
+	}
+
+	m.carousel.SetCursor(threadsTabIndex)
+	if m.threads.prUrl != m.pr.Data.Primary.Url {
+		m.threads = threadsState{prUrl: m.pr.Data.Primary.Url}
+	}
+	m.threads.isNavigating = true
+	m.threads.cursor = min(m.threads.cursor, max(0, len(m.sortedThreads())-1))
+	return nil
+}
+
+// sortedThreads returns the review threads of the PR grouped by file and
+// ordered by line
+func (m *Model) sortedThreads() []data.ReviewThread {
+	if !m.pr.Data.IsEnriched {
+		return nil
+	}
+
+	threads := make([]data.ReviewThread, len(m.pr.Data.Enriched.ReviewThreads.Nodes))
+	copy(threads, m.pr.Data.Enriched.ReviewThreads.Nodes)
+	sort.SliceStable(threads, func(i, j int) bool {
+		if threads[i].Path != threads[j].Path {
+			return threads[i].Path < threads[j].Path
+		}
+		return threadLine(threads[i]) < threadLine(threads[j])
+	})
+	return threads
+}
+
+// threadLine returns the line a thread is on. Outdated threads are no longer
+// on a line of the diff so their original line is used instead.
+func threadLine(thread data.ReviewThread) int {
+	if thread.Line == 0 {
+		return thread.OriginalLine
+	}
+	return thread.Line
+}
+
+func (m *Model) selectedThread() *data.ReviewThread {
+	threads := m.sortedThreads()
+	if m.threads.cursor >= len(threads) {
+		return nil
+	}
+	return &threads[m.threads.cursor]
+}
+
+func (m *Model) updateThreads(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.ReviewThreadKeys.Down):
+		m.threads.cursor = min(m.threads.cursor+1, max(0, len(m.sortedThreads())-1))
+	case key.Matches(msg, keys.ReviewThreadKeys.Up):
+		m.threads.cursor = max(m.threads.cursor-1, 0)
+	case key.Matches(msg, keys.ReviewThreadKeys.Reply):
+		return m.enterThreadReply()
+	case key.Matches(msg, keys.ReviewThreadKeys.ToggleResolved):
+		return m.toggleThreadResolved()
+	case key.Matches(msg, keys.ReviewThreadKeys.Back):
+		m.threads.isNavigating = false
+	}
+
+	return nil
+}
+
+func (m *Model) enterThreadReply() tea.Cmd {
+	thread := m.selectedThread()
+	if thread == nil {
+		return nil
+	}
+
+	if !thread.ViewerCanReply {
+		m.ctx.Error = fmt.Errorf("you can't reply to this thread")
+		return nil
+	}
+	m.threads.replyThreadId = thread.Id
+
+	m.editor.SetAutocompleteSource(&fuzzyselect.UserMentionSource{WithAtSymbol: true})
+	return m.editor.Enter(cmpcontroller.EnterOptions{
+		Mode:                             cmpcontroller.ModeThreadReply,
+		Prompt:                           constants.ThreadReplyPrompt,
+		Repo:                             m.repoRef(),
+		EnterFetch:                       cmpcontroller.FetchSilent,
+		ConfirmDiscardOnCancel:           true,
+		HideAutocompleteWhenContextEmpty: true,
+	})
+}
+
+func (m *Model) submitThreadReply(sid tasks.SectionIdentifier, body string) tea.Cmd {
+	if len(strings.TrimSpace(body)) == 0 || m.threads.replyThreadId == "" {
+		return nil
+	}
+
+	threadId := m.threads.replyThreadId
+	m.threads.replyThreadId = ""
+	return tasks.ReplyToPRReviewThread(
+		m.ctx,
+		sid,
+		m.pr.Data.Primary.Url,
+		m.pr.Data.Primary.Number,
+		threadId,
+		body,
+	)
+}
+
+func (m *Model) toggleThreadResolved() tea.Cmd {
+	thread := m.selectedThread()
+	if thread == nil {
+		return nil
+	}
+
+	resolve := !thread.IsResolved
+	if (resolve && !thread.ViewerCanResolve) || (!resolve && !thread.ViewerCanUnresolve) {
+		m.ctx.Error = fmt.Errorf("you can't resolve or unresolve this thread")
+		return nil
+	}
+
+	sid := tasks.SectionIdentifier{Id: m.sectionId, Type: prssection.SectionType}
+	return tasks.SetPRReviewThreadResolved(
+		m.ctx,
+		sid,
+		m.pr.Data.Primary.Url,
+		m.pr.Data.Primary.Number,
+		thread.Id,
+		resolve,
+	)
+}
+
+// ThreadsCursorLine returns the line of the rendered view the selected thread
+// starts on, so the sidebar can keep it visible
+func (m *Model) ThreadsCursorLine() int {
+	if !m.IsNavigatingThreads() {
+		return 0
+	}
+
+	rendered := m.renderThreads()
+	line := lipgloss.Height(m.viewHeader()) + lipgloss.Height(m.renderThreadsTitle())
+	for i := 0; i < m.threads.cursor && i < len(rendered); i++ {
+		line += lipgloss.Height(rendered[i])
+	}
+	return line
+}
+
+func (m *Model) renderThreadsTab() string {
+	if !m.pr.Data.IsEnriched {
+		return m.ctx.Styles.Common.FaintTextStyle.Render("Loading...")
+	}
+
+	if len(m.pr.Data.Enriched.ReviewThreads.Nodes) == 0 {
+		return lipgloss.NewStyle().Italic(true).Render("No review threads...")
+	}
+
+	body := strings.Builder{}
+	body.WriteString(m.renderThreadsTitle())
+	body.WriteString("\n")
+	body.WriteString(lipgloss.JoinVertical(lipgloss.Left, m.renderThreads()...))
+
+	if !m.IsNavigatingThreads() {
+		body.WriteString("\n\n")
+		body.WriteString(m.ctx.Styles.Common.FaintTextStyle.Render(
+			fmt.Sprintf("Press %s to reply to or resolve threads", keys.PRKeys.ReviewThreads.Help().Key),
+		))
+	}
+
+	if m.editor.Mode() == cmpcontroller.ModeThreadReply {
+		body.WriteString("\n")
+		body.WriteString(m.ctx.Styles.Sidebar.InputBox.Render(m.editor.View()))
+	}
+
+	return body.String()
+}
+
+func (m *Model) renderThreadsTitle() string {
+	unresolved := 0
+	for _, thread := range m.pr.Data.Enriched.ReviewThreads.Nodes {
+		if !thread.IsResolved {
+			unresolved++
+		}
+	}
+
+	return m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(
+		fmt.Sprintf(
+			"%s  %d threads, %d unresolved",
+			constants.CommentsIcon,
+			len(m.pr.Data.Enriched.ReviewThreads.Nodes),
+			unresolved,
+		))
+}
+
+// renderThreads renders each thread, preceded by the name of its file when
+// it's the first thread on that file
+func (m *Model) renderThreads() []string {
+	width := m.getIndentedContentWidth()
+	markdownRenderer := markdown.GetMarkdownRenderer(max(0, width-2), m.ctx)
+
+	threads := m.sortedThreads()
+	rendered := make([]string, 0, len(threads))
+	for i, thread := range threads {
+		var parts []string
+		if i == 0 || threads[i-1].Path != thread.Path {
+			if i != 0 {
+				parts = append(parts, "")
+			}
+			parts = append(parts, m.ctx.Styles.Common.MainTextStyle.Bold(true).Render(thread.Path))
+		}
+
+		isSelected := m.IsNavigatingThreads() && i == m.threads.cursor
+		parts = append(parts, m.renderThread(thread, isSelected, markdownRenderer))
+		rendered = append(rendered, lipgloss.JoinVertical(lipgloss.Left, parts...))
+	}
+	return rendered
+}
+
+func (m *Model) renderThread(
+	thread data.ReviewThread,
+	isSelected bool,
+	markdownRenderer glamour.TermRenderer,
+) string {
+	faint := m.ctx.Styles.Common.FaintTextStyle
+
+	glyph := m.ctx.Styles.Common.CommentGlyph
+	status := "Unresolved"
+	if thread.IsResolved {
+		glyph = m.ctx.Styles.Common.SuccessGlyph
+		status = "Resolved"
+	}
+	if thread.IsOutdated {
+		status += " · Outdated"
+	}
+
+	lines := fmt.Sprintf("L%d", threadLine(thread))
+	if thread.StartLine != 0 && thread.StartLine != thread.Line {
+		lines = fmt.Sprintf("L%d-%d", thread.StartLine, thread.Line)
+	}
+	header := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		glyph,
+		" ",
+		m.ctx.Styles.Common.MainTextStyle.Render(lines),
+		" ",
+		faint.Render(status),
+	)
+
+	parts := []string{header}
+	// Resolved threads are collapsed unless selected, as they rarely need
+	// more attention
+	if thread.IsResolved && !isSelected {
+		parts = append(parts, faint.Render(fmt.Sprintf("%d comments", thread.Comments.TotalCount)))
+	} else {
+		for _, c := range thread.Comments.Nodes {
+			author := lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.ctx.Styles.Common.MainTextStyle.Render(c.Author.Login),
+				" ",
+				faint.Render(utils.TimeElapsed(c.UpdatedAt)),
+			)
+			body, err := markdownRenderer.Render(lineCleanupRegex.ReplaceAllString(c.Body, ""))
+			if err != nil {
+				body = c.Body
+			}
+			parts = append(parts, author, strings.TrimRight(body, "\n"))
+		}
+	}
+
+	borderColor := m.ctx.Theme.FaintBorder
+	if isSelected {
+		borderColor = m.ctx.Theme.PrimaryBorder
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.ThickBorder(), false, false, false, true).
+		BorderForeground(borderColor).
+		PaddingLeft(1).
+		MarginTop(1).
+		Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
@@ -0,0 +1,58 @@
+package prview
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func newThreadsTestModel(t *testing.T) Model {
+	t.Helper()
+	m := newTestModel(t, &data.PullRequestData{
+		Number: 1,
+		Url:    "https://github.com/owner/repo/pull/1",
+	}, nil, nil)
+	m.pr.Data.Enriched.ReviewThreads.Nodes = []data.ReviewThread{
+		{Id: "b-20", Path: "b.go", Line: 20},
+		{Id: "a-outdated", Path: "a.go", OriginalLine: 30, IsOutdated: true},
+		{Id: "a-10", Path: "a.go", Line: 10, IsResolved: true, ViewerCanUnresolve: true},
+	}
+	m.StartNavigatingThreads()
+	return m
+}
+
+func TestSortedThreadsGroupsByFile(t *testing.T) {
+	m := newThreadsTestModel(t)
+
+	var ids []string
+	for _, thread := range m.sortedThreads() {
+		ids = append(ids, thread.Id)
+	}
+	require.Equal(t, []string{"a-10", "a-outdated", "b-20"}, ids)
+}
+
+func TestThreadsNavigation(t *testing.T) {
+	m := newThreadsTestModel(t)
+	require.True(t, m.IsNavigatingThreads())
+	require.Equal(t, threadsTabIndex, m.carousel.Cursor())
+
+	m.updateThreads(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	m.updateThreads(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	m.updateThreads(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	require.Equal(t, "b-20", m.selectedThread().Id, "cursor should stop at the last thread")
+
+	m.updateThreads(tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.False(t, m.IsNavigatingThreads())
+}
+
+func TestThreadsReplyNeedsPermission(t *testing.T) {
+	m := newThreadsTestModel(t)
+
+	cmd := m.enterThreadReply()
+	require.Nil(t, cmd)
+	require.Error(t, m.ctx.Error)
+	require.Empty(t, m.threads.replyThreadId)
+}
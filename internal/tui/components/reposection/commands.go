@@ -255,6 +255,7 @@ func (m *Model) fetchPRsCmd() tea.Cmd {
 			fmt.Sprintf("author:@me repo:%s", git.GetRepoShortName(m.Ctx.RepoUrl)),
 			*limit,
 			nil,
+			"",
 		)
 		if err != nil {
 			return constants.TaskFinishedMsg{
@@ -293,6 +294,7 @@ func (m *Model) fetchPRCmd(branch string) []tea.Cmd {
 			fmt.Sprintf("author:@me repo:%s head:%s", git.GetRepoShortName(m.Ctx.RepoUrl), branch),
 			1,
 			nil,
+			"",
 		)
 		log.Debug("Fetching PRs", "res", res)
 		if err != nil {
@@ -357,9 +359,12 @@ func (m *Model) tickRefreshBranchesCmd() tea.Cmd {
 	)
 }
 
+// tickFetchPrsCmd schedules the next refetch of the PRs of the branches,
+// later than configured when the rate limit budget runs low. Branches are read
+// from the local repo so their refresh needn't back off.
 func (m *Model) tickFetchPrsCmd() tea.Cmd {
 	return tea.Tick(
-		time.Second*time.Duration(m.Ctx.Config.Repo.PrsRefetchIntervalSeconds),
+		data.RefetchDelay(time.Second*time.Duration(m.Ctx.Config.Repo.PrsRefetchIntervalSeconds)),
 		func(t time.Time) tea.Msg {
 			return RefreshPrsMsg{id: m.refreshId, time: t}
 		},
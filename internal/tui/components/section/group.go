@@ -0,0 +1,45 @@
+package section
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+)
+
+// Groupable is implemented by sections that can group their rows under
+// headers, e.g. by repo
+type Groupable interface {
+	// ToggleCurrGroup folds or unfolds the group of the current row
+	ToggleCurrGroup() (title string, collapsed bool, ok bool)
+}
+
+func (m *BaseModel) ToggleCurrGroup() (title string, collapsed bool, ok bool) {
+	return m.Table.ToggleCurrGroup()
+}
+
+// GroupRows orders the rows so the rows of a group are consecutive and
+// returns the groups for the table. Groups are ordered by their first row,
+// and rows keep their order within a group.
+func GroupRows[T any](rows []T, groupOf func(T) string) []table.Group {
+	order := make(map[string]int)
+	for _, row := range rows {
+		title := groupOf(row)
+		if _, ok := order[title]; !ok {
+			order[title] = len(order)
+		}
+	}
+
+	slices.SortStableFunc(rows, func(a, b T) int {
+		return cmp.Compare(order[groupOf(a)], order[groupOf(b)])
+	})
+
+	groups := make([]table.Group, len(order))
+	for title, i := range order {
+		groups[i].Title = title
+	}
+	for _, row := range rows {
+		groups[order[groupOf(row)]].Size++
+	}
+	return groups
+}
@@ -0,0 +1,117 @@
+package section
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+type groupTestRow struct {
+	name string
+	repo string
+}
+
+func TestGroupRows(t *testing.T) {
+	rows := []groupTestRow{
+		{name: "a1", repo: "org/a"},
+		{name: "b1", repo: "org/b"},
+		{name: "a2", repo: "org/a"},
+		{name: "c1", repo: "org/c"},
+		{name: "b2", repo: "org/b"},
+	}
+
+	groups := GroupRows(rows, func(r groupTestRow) string { return r.repo })
+
+	require.Equal(t, []table.Group{
+		{Title: "org/a", Size: 2},
+		{Title: "org/b", Size: 2},
+		{Title: "org/c", Size: 1},
+	}, groups)
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		names = append(names, r.name)
+	}
+	require.Equal(t, []string{"a1", "a2", "b1", "b2", "c1"}, names)
+}
+
+func TestGroupedTableNavigation(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+	thm := theme.ParseTheme(&cfg)
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		Theme:  thm,
+		Styles: context.InitStyles(thm),
+	}
+	m := BaseModel{
+		Ctx: ctx,
+		Table: table.NewModel(
+			*ctx,
+			constants.Dimensions{Width: 80, Height: 40},
+			time.Now(),
+			time.Now(),
+			[]table.Column{{Title: "Title"}},
+			nil,
+			"pr",
+			nil,
+			"Loading...",
+			false,
+		),
+	}
+	m.Table.SetRows([]table.Row{{"a1"}, {"a2"}, {"b1"}})
+	m.Table.SetGroups([]table.Group{
+		{Title: "org/a", Size: 2},
+		{Title: "org/b", Size: 1},
+	})
+
+	// Headers are items of their own, with no current row
+	m.FirstItem()
+	require.Equal(t, -1, m.CurrRow())
+	require.Equal(t, 0, m.NextRow())
+	require.Equal(t, 1, m.NextRow())
+	require.Equal(t, -1, m.NextRow())
+	require.Equal(t, 2, m.NextRow())
+	require.Equal(t, 2, m.NextRow())
+
+	// Folding from a row moves to the group header and skips its rows
+	m.FirstItem()
+	m.NextRow()
+	title, collapsed, ok := m.ToggleCurrGroup()
+	require.True(t, ok)
+	require.Equal(t, "org/a", title)
+	require.True(t, collapsed)
+	require.Equal(t, -1, m.CurrRow())
+	require.Equal(t, -1, m.NextRow())
+	require.Equal(t, 2, m.NextRow())
+	require.Equal(t, 2, m.LastItem())
+
+	// Groups stay folded when the rows are regrouped
+	m.Table.SetGroups([]table.Group{
+		{Title: "org/a", Size: 2},
+		{Title: "org/b", Size: 1},
+	})
+	m.FirstItem()
+	require.Equal(t, -1, m.NextRow())
+	require.Equal(t, 2, m.NextRow())
+
+	m.FirstItem()
+	_, collapsed, _ = m.ToggleCurrGroup()
+	require.False(t, collapsed)
+	require.Equal(t, 0, m.NextRow())
+}
+
+func TestToggleCurrGroup_Ungrouped(t *testing.T) {
+	m := BaseModel{}
+	_, _, ok := m.ToggleCurrGroup()
+	require.False(t, ok)
+}
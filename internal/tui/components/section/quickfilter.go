@@ -0,0 +1,116 @@
+package section
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/sahilm/fuzzy"
+)
+
+// QuickFilterable is implemented by sections whose loaded rows can be
+// narrowed down without searching GitHub again
+type QuickFilterable interface {
+	SetIsQuickFiltering(val bool) tea.Cmd
+	IsQuickFilterFocused() bool
+	GetQuickFilter() string
+	ClearQuickFilter()
+	// FilterableRows returns the fields the quick filter matches the rows
+	// on, in the order of the rows
+	FilterableRows() []FilterableRow
+}
+
+// FilterableRow holds the fields of a row the quick filter matches on
+type FilterableRow struct {
+	Title  string
+	Author string
+	Repo   string
+	Branch string
+	Labels []string
+}
+
+// Matches reports whether every term of the filter fuzzy matches one of the
+// fields of the row, e.g. "migr dlvhdr" matches a PR titled "Add migration"
+// by dlvhdr
+func (r FilterableRow) Matches(filter string) bool {
+	fields := append([]string{r.Title, r.Author, r.Repo, r.Branch}, r.Labels...)
+	for term := range strings.FieldsSeq(filter) {
+		if len(fuzzy.FindNoSort(term, fields)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterRows returns the indices of the rows matching the filter, or nil
+// when the filter is empty so all the rows are shown
+func FilterRows(filter string, rows []FilterableRow) map[int]bool {
+	if strings.TrimSpace(filter) == "" {
+		return nil
+	}
+
+	matching := make(map[int]bool)
+	for i, row := range rows {
+		if row.Matches(filter) {
+			matching[i] = true
+		}
+	}
+	return matching
+}
+
+func (m *BaseModel) IsQuickFilterFocused() bool {
+	return m.IsQuickFiltering
+}
+
+func (m *BaseModel) GetQuickFilter() string {
+	return m.QuickFilter
+}
+
+func (m *BaseModel) SetIsQuickFiltering(val bool) tea.Cmd {
+	m.IsQuickFiltering = val
+	if val {
+		m.QuickFilterBox.SetPrompt(" filter: ")
+		m.QuickFilterBox.SetValue(m.QuickFilter)
+		return m.QuickFilterBox.Focus()
+	}
+
+	m.QuickFilterBox.Blur()
+	return nil
+}
+
+// ClearQuickFilter shows all the loaded rows again
+func (m *BaseModel) ClearQuickFilter() {
+	m.QuickFilter = ""
+	m.QuickFilterBox.Reset()
+	m.Table.SetFilteredRows(nil)
+}
+
+// UpdateQuickFilter handles a key typed in the focused quick filter. Enter
+// keeps the rows narrowed down and Esc clears the filter.
+func (m *BaseModel) UpdateQuickFilter(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "ctrl+c", "esc":
+		m.ClearQuickFilter()
+		return m.SetIsQuickFiltering(false)
+
+	case "enter":
+		return m.SetIsQuickFiltering(false)
+	}
+
+	var cmd tea.Cmd
+	m.QuickFilterBox, cmd = m.QuickFilterBox.Update(msg)
+	if value := m.QuickFilterBox.Value(); value != m.QuickFilter {
+		m.QuickFilter = value
+		m.Table.ResetCurrItem()
+	}
+	return cmd
+}
+
+// ApplyQuickFilter only shows the rows matching the quick filter. Sections
+// call it whenever their rows change, rows being their filterable fields.
+func (m *BaseModel) ApplyQuickFilter(rows []FilterableRow) {
+	matching := FilterRows(m.QuickFilter, rows)
+	if matching == nil && !m.Table.IsFiltered() {
+		return
+	}
+	m.Table.SetFilteredRows(matching)
+}
@@ -0,0 +1,156 @@
+package section
+
+import (
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prompt"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+var quickFilterRows = []FilterableRow{
+	{
+		Title:  "Add database migration for users",
+		Author: "dlvhdr",
+		Repo:   "dlvhdr/gh-dash",
+		Branch: "users-migration",
+	},
+	{
+		Title:  "Fix crash on startup",
+		Author: "octocat",
+		Repo:   "cli/cli",
+		Branch: "fix-crash",
+		Labels: []string{"bug"},
+	},
+	{
+		Title:  "Migrate docs to astro",
+		Author: "octocat",
+		Repo:   "dlvhdr/gh-dash",
+		Branch: "docs",
+		Labels: []string{"documentation"},
+	},
+}
+
+func TestFilterRows(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   map[int]bool
+	}{
+		{filter: "", want: nil},
+		{filter: "  ", want: nil},
+		{filter: "migr", want: map[int]bool{0: true, 2: true}},
+		{filter: "MIGRATION", want: map[int]bool{0: true}},
+		{filter: "migr octo", want: map[int]bool{2: true}},
+		{filter: "ghdash", want: map[int]bool{0: true, 2: true}},
+		{filter: "fix-cr", want: map[int]bool{1: true}},
+		{filter: "bug", want: map[int]bool{1: true}},
+		{filter: "xyz", want: map[int]bool{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.filter, func(t *testing.T) {
+			require.Equal(t, tc.want, FilterRows(tc.filter, quickFilterRows))
+		})
+	}
+}
+
+func newQuickFilterTestModel(t *testing.T) BaseModel {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../../../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+	thm := theme.ParseTheme(&cfg)
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		Theme:  thm,
+		Styles: context.InitStyles(thm),
+	}
+	return BaseModel{
+		Ctx:            ctx,
+		QuickFilterBox: prompt.NewModel(ctx),
+		Table: table.NewModel(
+			*ctx,
+			constants.Dimensions{Width: 80, Height: 40},
+			time.Now(),
+			time.Now(),
+			[]table.Column{{Title: "Title"}},
+			nil,
+			"pr",
+			nil,
+			"Loading...",
+			false,
+		),
+	}
+}
+
+func TestQuickFilter(t *testing.T) {
+	m := newQuickFilterTestModel(t)
+	m.Table.SetRows([]table.Row{{"a"}, {"b"}, {"c"}})
+
+	m.SetIsQuickFiltering(true)
+	require.True(t, m.IsQuickFilterFocused())
+	for _, r := range "migr" {
+		m.UpdateQuickFilter(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	m.ApplyQuickFilter(quickFilterRows)
+	require.Equal(t, "migr", m.GetQuickFilter())
+	require.Equal(t, 0, m.FirstItem())
+	require.Equal(t, 2, m.NextRow())
+	require.Equal(t, 2, m.NextRow())
+
+	// Enter keeps the rows narrowed down
+	m.UpdateQuickFilter(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.False(t, m.IsQuickFilterFocused())
+	m.ApplyQuickFilter(quickFilterRows)
+	require.Equal(t, 0, m.FirstItem())
+	require.Equal(t, 2, m.LastItem())
+
+	// Nothing matching shows no row
+	m.QuickFilter = "xyz"
+	m.ApplyQuickFilter(quickFilterRows)
+	require.Equal(t, -1, m.FirstItem())
+
+	// Esc restores all the rows
+	m.SetIsQuickFiltering(true)
+	m.UpdateQuickFilter(tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.False(t, m.IsQuickFilterFocused())
+	require.Empty(t, m.GetQuickFilter())
+	m.ApplyQuickFilter(quickFilterRows)
+	require.False(t, m.Table.IsFiltered())
+	require.Equal(t, 0, m.FirstItem())
+	require.Equal(t, 1, m.NextRow())
+}
+
+func TestQuickFilter_GroupedRows(t *testing.T) {
+	m := newQuickFilterTestModel(t)
+	m.Table.SetRows([]table.Row{{"a1"}, {"a2"}, {"b1"}})
+	m.Table.SetGroups([]table.Group{
+		{Title: "org/a", Size: 2},
+		{Title: "org/b", Size: 1},
+	})
+
+	// The group without matching rows is hidden
+	m.QuickFilter = "fix"
+	m.ApplyQuickFilter([]FilterableRow{
+		{Title: "Add feature"},
+		{Title: "Fix bug"},
+		{Title: "Add docs"},
+	})
+	require.Equal(t, -1, m.FirstItem())
+	require.Equal(t, 1, m.NextRow())
+	require.Equal(t, 1, m.NextRow())
+
+	// The header counts the rows left shown
+	view := ansi.Strip(m.Table.View())
+	require.Contains(t, view, "org/a (1)")
+	require.NotContains(t, view, "org/b")
+}
@@ -49,6 +49,15 @@ type BaseModel struct {
 	ShowAuthorIcon            bool
 	IsFilteredByCurrentRemote bool
 	IsLoading                 bool
+	// SelectedUrls holds the urls of the rows selected for bulk actions
+	SelectedUrls map[string]bool
+	// SortKeys are the keys the rows are sorted by on the client, the rows
+	// keep the order of the search results when it's empty
+	SortKeys []config.SortKey
+	// QuickFilter narrows down the loaded rows without searching again
+	QuickFilter      string
+	QuickFilterBox   prompt.Model
+	IsQuickFiltering bool
 }
 
 type NewSectionOptions struct {
@@ -67,7 +76,8 @@ func (options NewSectionOptions) GetConfigFiltersWithCurrentRemoteAdded(
 	ctx *context.ProgramContext,
 ) string {
 	searchValue := options.Config.Filters
-	if !ctx.Config.SmartFilteringAtLaunch {
+	// The --repo scope takes the place of the current remote
+	if !ctx.Config.SmartFilteringAtLaunch || ctx.RepoScope != "" {
 		return searchValue
 	}
 	if ctx.GHRepo == nil {
@@ -115,7 +125,9 @@ func NewModel(
 		TotalCount:                0,
 		PageInfo:                  nil,
 		PromptConfirmationBox:     prompt.NewModel(ctx),
+		QuickFilterBox:            prompt.NewModel(ctx),
 		ShowAuthorIcon:            ctx.Config.ShowAuthorIcons,
+		SortKeys:                  options.Config.Sort,
 	}
 	m.Table = table.NewModel(
 		*ctx,
@@ -208,8 +220,15 @@ func (m *BaseModel) GetConfig() config.SectionConfig {
 	return m.Config
 }
 
+func (m *BaseModel) GetSortKeys() []config.SortKey {
+	return m.SortKeys
+}
+
 func (m *BaseModel) HasRepoNameInConfiguredFilter() bool {
-	filters := m.SearchValue
+	return hasRepoFilter(m.SearchValue)
+}
+
+func hasRepoFilter(filters string) bool {
 	for token := range strings.FieldsSeq(filters) {
 		if strings.HasPrefix(token, "repo:") {
 			return true
@@ -238,6 +257,9 @@ func (m *BaseModel) SyncSmartFilterWithSearchValue() {
 
 func (m *BaseModel) GetSearchValue() string {
 	searchValue := m.enrichSearchWithTemplateVars()
+	if m.Ctx.RepoScope != "" && !hasRepoFilter(searchValue) {
+		searchValue = fmt.Sprintf("repo:%s %s", m.Ctx.RepoScope, searchValue)
+	}
 	if m.Ctx.GHRepo == nil {
 		return searchValue
 	}
@@ -260,7 +282,12 @@ func (m *BaseModel) GetSearchValue() string {
 }
 
 func (m *BaseModel) enrichSearchWithTemplateVars() string {
-	searchValue := m.SearchValue
+	return EnrichSearchWithTemplateVars(m.SearchValue)
+}
+
+// EnrichSearchWithTemplateVars renders the template functions (e.g. nowModify)
+// used in section filters into a plain GitHub search query.
+func EnrichSearchWithTemplateVars(searchValue string) string {
 	searchVars := struct{ Now time.Time }{
 		Now: time.Now(),
 	}
@@ -415,6 +442,40 @@ func (m *BaseModel) MakeSectionCmd(cmd tea.Cmd) tea.Cmd {
 	}
 }
 
+// SaveSectionMsg asks to save the search of a section as a new section of
+// the same type in the config
+type SaveSectionMsg struct {
+	SectionType string
+	Title       string
+	Filters     string
+	Host        string
+}
+
+// SaveSection saves the current search as a section titled title, or after
+// the section's own title when it's empty
+func (m *BaseModel) SaveSection(title string) tea.Cmd {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = m.defaultSavedSectionTitle()
+	}
+	msg := SaveSectionMsg{
+		SectionType: m.Type,
+		Title:       title,
+		Filters:     m.SearchValue,
+		Host:        m.Config.Host,
+	}
+	return func() tea.Msg {
+		return msg
+	}
+}
+
+func (m *BaseModel) defaultSavedSectionTitle() string {
+	if m.Config.Title != "" {
+		return m.Config.Title
+	}
+	return m.SearchValue
+}
+
 func (m *BaseModel) GetFilters() string {
 	return m.GetSearchValue()
 }
@@ -442,6 +503,14 @@ func (m *BaseModel) GetMainContent() string {
 
 func (m *BaseModel) View() string {
 	search := m.SearchBar.View(m.Ctx)
+	// The quick filter takes the place of the search bar while it's used
+	if m.IsQuickFiltering || m.QuickFilter != "" {
+		s := m.Ctx.Styles.Search.Root.Width(lipgloss.Width(search))
+		if m.IsQuickFiltering {
+			s = s.BorderForeground(m.Ctx.Styles.Colors.OpenIssue)
+		}
+		search = s.Render(m.QuickFilterBox.View())
+	}
 	return m.Ctx.Styles.Section.ContainerStyle.
 		Width(m.Ctx.MainContentWidth).
 		Render(
@@ -473,19 +542,26 @@ func (m *BaseModel) UpdateTotalItemsCount(count int) {
 
 func (m *BaseModel) GetPromptConfirmation() string {
 	if m.IsPromptConfirmationShown {
+		// Closing, reopening and merging run over the selected rows, if any
+		prSubject, issueSubject := "this PR", "this issue"
+		if n := len(m.SelectedUrls); n > 0 {
+			prSubject = fmt.Sprintf("the %d selected PRs", n)
+			issueSubject = fmt.Sprintf("the %d selected issues", n)
+		}
+
 		var prompt string
 		switch {
 		case m.PromptConfirmationAction == "close" && m.Ctx.View == config.PRsView:
-			prompt = "Are you sure you want to close this PR? (y/N) "
+			prompt = fmt.Sprintf("Are you sure you want to close %s? (y/N) ", prSubject)
 
 		case m.PromptConfirmationAction == "reopen" && m.Ctx.View == config.PRsView:
-			prompt = "Are you sure you want to reopen this PR? (y/N) "
+			prompt = fmt.Sprintf("Are you sure you want to reopen %s? (y/N) ", prSubject)
 
 		case m.PromptConfirmationAction == "ready" && m.Ctx.View == config.PRsView:
 			prompt = "Are you sure you want to mark this PR as ready? (y/N) "
 
 		case m.PromptConfirmationAction == "merge" && m.Ctx.View == config.PRsView:
-			prompt = "Are you sure you want to merge this PR? (y/N) "
+			prompt = fmt.Sprintf("Are you sure you want to merge %s? (y/N) ", prSubject)
 
 		case m.PromptConfirmationAction == "update" && m.Ctx.View == config.PRsView:
 			prompt = "Are you sure you want to update this PR? (y/N) "
@@ -494,10 +570,14 @@ func (m *BaseModel) GetPromptConfirmation() string {
 			prompt = "Are you sure you want to approve all workflows? (y/N) "
 
 		case m.PromptConfirmationAction == "close" && m.Ctx.View == config.IssuesView:
-			prompt = "Are you sure you want to close this issue? (y/N) "
+			prompt = fmt.Sprintf("Are you sure you want to close %s? (y/N) ", issueSubject)
 
 		case m.PromptConfirmationAction == "reopen" && m.Ctx.View == config.IssuesView:
-			prompt = "Are you sure you want to reopen this issue? (y/N) "
+			prompt = fmt.Sprintf("Are you sure you want to reopen %s? (y/N) ", issueSubject)
+
+		case m.PromptConfirmationAction == "select" &&
+			(m.Ctx.View == config.PRsView || m.Ctx.View == config.IssuesView):
+			prompt = "Select rows matching (e.g. label:bug is:open fix): "
 		case m.PromptConfirmationAction == "delete" && m.Ctx.View == config.RepoView:
 			prompt = "Are you sure you want to delete this branch? (y/N) "
 		case m.PromptConfirmationAction == "new" && m.Ctx.View == config.RepoView:
@@ -506,6 +586,11 @@ func (m *BaseModel) GetPromptConfirmation() string {
 			prompt = "Enter PR title: "
 		case m.PromptConfirmationAction == "done_all" && m.Ctx.View == config.NotificationsView:
 			prompt = "Are you sure you want to mark all as done? (y/N) "
+		case m.PromptConfirmationAction == "save_section":
+			prompt = fmt.Sprintf(
+				"Save the search as a section titled (default %q): ",
+				m.defaultSavedSectionTitle(),
+			)
 		}
 
 		m.PromptConfirmationBox.SetPrompt(prompt)
@@ -429,6 +429,54 @@ func TestGetConfigFiltersWithCurrentRemoteAdded(t *testing.T) {
 	}
 }
 
+func TestGetSearchValue_RepoScope(t *testing.T) {
+	repo := repository.Repository{Owner: "dlvhdr", Name: "gh-dash"}
+	currentRepoFilter(t, repo)
+
+	tests := []struct {
+		name        string
+		searchValue string
+		want        string
+	}{
+		{
+			name:        "scope is added",
+			searchValue: "is:open author:@me",
+			want:        "repo:org/api is:open author:@me",
+		},
+		{
+			name:        "section's own repo filter is kept",
+			searchValue: "repo:org/web is:open",
+			want:        "repo:org/web is:open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := BaseModel{SearchValue: tt.searchValue}
+			m.Ctx = &context.ProgramContext{GHRepo: &repo, RepoScope: "org/api"}
+
+			require.Equal(t, tt.want, m.GetSearchValue())
+		})
+	}
+}
+
+func TestGetConfigFiltersWithCurrentRemoteAdded_RepoScope(t *testing.T) {
+	repo := repository.Repository{Owner: "dlvhdr", Name: "gh-dash"}
+	currentRepoFilter(t, repo)
+
+	options := NewSectionOptions{
+		Config: config.SectionConfig{Filters: "is:open"},
+	}
+	ctx := &context.ProgramContext{
+		Config:    &config.Config{SmartFilteringAtLaunch: true},
+		GHRepo:    &repo,
+		RepoScope: "org/api",
+	}
+
+	require.Equal(t, "is:open", options.GetConfigFiltersWithCurrentRemoteAdded(ctx),
+		"the current remote shouldn't be added when scoped to a repo")
+}
+
 func TestGetPromptConfirmation(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -454,6 +502,12 @@ func TestGetPromptConfirmation(t *testing.T) {
 			view:         config.PRsView,
 			wantNonEmpty: true,
 		},
+		{
+			name:         "save_section in issues view shows prompt",
+			action:       "save_section",
+			view:         config.IssuesView,
+			wantNonEmpty: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -482,6 +536,30 @@ func TestGetPromptConfirmation(t *testing.T) {
 	}
 }
 
+func TestSaveSection(t *testing.T) {
+	m := BaseModel{
+		Type:        "issue",
+		SearchValue: "is:open label:bug",
+		Config:      config.SectionConfig{Title: "Open", Host: "github.example.com"},
+	}
+
+	msg := m.SaveSection("  Bugs ")()
+	require.Equal(t, SaveSectionMsg{
+		SectionType: "issue",
+		Title:       "Bugs",
+		Filters:     "is:open label:bug",
+		Host:        "github.example.com",
+	}, msg)
+
+	msg = m.SaveSection("")()
+	require.Equal(t, "Open", msg.(SaveSectionMsg).Title)
+
+	// The search section has no title of its own
+	m.Config.Title = ""
+	msg = m.SaveSection("")()
+	require.Equal(t, "is:open label:bug", msg.(SaveSectionMsg).Title)
+}
+
 func TestViewRendersAtMainContentWidth(t *testing.T) {
 	cfg, err := config.ParseConfig(config.Location{
 		ConfigFlag:       "../../../config/testdata/test-config.yml",
@@ -0,0 +1,135 @@
+package section
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// Selection is implemented by sections whose rows can be selected to run an
+// action over all of them at once
+type Selection interface {
+	ToggleCurrRowSelection()
+	SelectAllRows()
+	SelectMatchingRows(filter string) int
+	ClearSelection()
+	NumSelected() int
+	GetSelectedRows() []data.RowData
+}
+
+// SelectableRow holds the fields of a row that "select matching" filters on
+type SelectableRow struct {
+	Url     string
+	Number  int
+	Title   string
+	Repo    string
+	Author  string
+	State   string
+	IsDraft bool
+	Labels  []string
+}
+
+// Matches reports whether the row matches every term of the filter. Terms can
+// be qualified with repo:, author:, label: or is: (open, closed, merged or
+// draft), other terms have to appear in the title or be the number of the row.
+func (r SelectableRow) Matches(filter string) bool {
+	for term := range strings.FieldsSeq(strings.ToLower(filter)) {
+		if !r.matchesTerm(term) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r SelectableRow) matchesTerm(term string) bool {
+	qualifier, value, found := strings.Cut(term, ":")
+	if !found {
+		return strings.Contains(strings.ToLower(r.Title), term) ||
+			term == fmt.Sprintf("#%d", r.Number)
+	}
+
+	switch qualifier {
+	case "repo":
+		return strings.EqualFold(r.Repo, value)
+	case "author":
+		return strings.EqualFold(r.Author, value)
+	case "label":
+		for _, label := range r.Labels {
+			if strings.EqualFold(label, value) {
+				return true
+			}
+		}
+		return false
+	case "is":
+		if value == "draft" {
+			return r.IsDraft
+		}
+		return strings.EqualFold(r.State, value)
+	}
+
+	return strings.Contains(strings.ToLower(r.Title), term)
+}
+
+func (m *BaseModel) IsSelected(url string) bool {
+	return m.SelectedUrls[url]
+}
+
+func (m *BaseModel) ToggleSelected(url string) {
+	if m.SelectedUrls == nil {
+		m.SelectedUrls = make(map[string]bool)
+	}
+	if m.SelectedUrls[url] {
+		delete(m.SelectedUrls, url)
+	} else {
+		m.SelectedUrls[url] = true
+	}
+}
+
+// SelectRows adds the rows with the given urls to the selection
+func (m *BaseModel) SelectRows(urls []string) {
+	if m.SelectedUrls == nil {
+		m.SelectedUrls = make(map[string]bool)
+	}
+	for _, url := range urls {
+		m.SelectedUrls[url] = true
+	}
+}
+
+func (m *BaseModel) ClearSelection() {
+	m.SelectedUrls = nil
+	m.Table.SetMarkedRows(nil)
+	m.Table.SyncViewPortContent()
+}
+
+func (m *BaseModel) NumSelected() int {
+	return len(m.SelectedUrls)
+}
+
+// MarkSelectedRows marks the selected rows in the table, rowUrls being the
+// urls of the rows in the order they're shown. Rows that are no longer shown,
+// e.g. after a refetch, are dropped from the selection.
+func (m *BaseModel) MarkSelectedRows(rowUrls []string) {
+	if len(m.SelectedUrls) == 0 {
+		m.Table.SetMarkedRows(nil)
+		return
+	}
+
+	marked := make(map[int]bool)
+	selected := make(map[string]bool)
+	for i, url := range rowUrls {
+		if m.SelectedUrls[url] {
+			marked[i] = true
+			selected[url] = true
+		}
+	}
+	m.SelectedUrls = selected
+	m.Table.SetMarkedRows(marked)
+}
+
+// ClearSelectionMsg clears the selection of a section once an action was run
+// over the selected rows from outside the section, e.g. from the sidebar
+type ClearSelectionMsg struct {
+	SectionId   int
+	SectionType string
+}
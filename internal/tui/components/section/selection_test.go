@@ -0,0 +1,57 @@
+package section
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectableRow_Matches(t *testing.T) {
+	row := SelectableRow{
+		Url:     "https://github.com/owner/repo/pull/12",
+		Number:  12,
+		Title:   "Fix flaky login test",
+		Repo:    "owner/repo",
+		Author:  "octocat",
+		State:   "OPEN",
+		IsDraft: true,
+		Labels:  []string{"bug", "CI"},
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{name: "empty filter", filter: "", want: true},
+		{name: "title term", filter: "flaky", want: true},
+		{name: "title term is case insensitive", filter: "LOGIN", want: true},
+		{name: "number", filter: "#12", want: true},
+		{name: "repo", filter: "repo:owner/repo", want: true},
+		{name: "other repo", filter: "repo:owner/other", want: false},
+		{name: "author", filter: "author:Octocat", want: true},
+		{name: "label", filter: "label:ci", want: true},
+		{name: "missing label", filter: "label:docs", want: false},
+		{name: "state", filter: "is:open", want: true},
+		{name: "draft", filter: "is:draft", want: true},
+		{name: "all terms have to match", filter: "fix is:closed", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, row.Matches(tt.filter))
+		})
+	}
+}
+
+func TestMarkSelectedRows_DropsRowsNoLongerShown(t *testing.T) {
+	m := BaseModel{}
+	m.SelectRows([]string{"a", "b", "c"})
+	m.ToggleSelected("b")
+
+	m.MarkSelectedRows([]string{"c", "d"})
+
+	require.Equal(t, 1, m.NumSelected())
+	require.True(t, m.IsSelected("c"))
+	require.False(t, m.IsSelected("a"))
+}
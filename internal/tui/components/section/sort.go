@@ -0,0 +1,119 @@
+package section
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// Sortable is implemented by sections that sort their rows on the client, by
+// fields GitHub search can't sort by
+type Sortable interface {
+	// CycleSortKey sorts the rows by the next sort key and returns it
+	CycleSortKey() config.SortKey
+	GetSortKeys() []config.SortKey
+}
+
+// SortableRow holds the fields of a row that sections sort by
+type SortableRow struct {
+	UpdatedAt      time.Time
+	CreatedAt      time.Time
+	ChecksState    string
+	ReviewDecision string
+	LinesChanged   int
+	Comments       int
+	Repo           string
+	Author         string
+}
+
+// SortRows sorts the rows by the keys, the first key being the primary one.
+// Rows that are equal by all keys keep the order of the search results.
+func SortRows[T any](rows []T, keys []config.SortKey, toSortable func(T) SortableRow) {
+	if len(keys) == 0 {
+		return
+	}
+
+	slices.SortStableFunc(rows, func(a, b T) int {
+		ra, rb := toSortable(a), toSortable(b)
+		for _, key := range keys {
+			if c := compareBy(key, ra, rb); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// compareBy orders the most relevant rows first: recently updated or created,
+// passing checks, approved, small, much discussed, and repos and authors
+// alphabetically
+func compareBy(key config.SortKey, a, b SortableRow) int {
+	switch key {
+	case config.SortByUpdated:
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	case config.SortByCreated:
+		return b.CreatedAt.Compare(a.CreatedAt)
+	case config.SortByChecks:
+		return cmp.Compare(checksRank(a.ChecksState), checksRank(b.ChecksState))
+	case config.SortByReviewDecision:
+		return cmp.Compare(reviewDecisionRank(a.ReviewDecision), reviewDecisionRank(b.ReviewDecision))
+	case config.SortByLinesChanged:
+		return cmp.Compare(a.LinesChanged, b.LinesChanged)
+	case config.SortByComments:
+		return cmp.Compare(b.Comments, a.Comments)
+	case config.SortByRepo:
+		return cmp.Compare(strings.ToLower(a.Repo), strings.ToLower(b.Repo))
+	case config.SortByAuthor:
+		return cmp.Compare(strings.ToLower(a.Author), strings.ToLower(b.Author))
+	}
+	return 0
+}
+
+func checksRank(state string) int {
+	switch state {
+	case "SUCCESS":
+		return 0
+	case "PENDING", "EXPECTED":
+		return 1
+	case "":
+		return 2
+	default:
+		return 3
+	}
+}
+
+func reviewDecisionRank(decision string) int {
+	switch decision {
+	case "APPROVED":
+		return 0
+	case "REVIEW_REQUIRED":
+		return 1
+	case "":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// NextSortKeys returns the keys to sort by after the current ones, cycling
+// through the available keys. The configured keys, which can have tie
+// breakers, take the place of their primary key in the cycle.
+func NextSortKeys(current, configured, available []config.SortKey) []config.SortKey {
+	primary := config.SortByUpdated
+	if len(current) > 0 {
+		primary = current[0]
+	}
+
+	next := available[0]
+	if i := slices.Index(available, primary); i >= 0 {
+		next = available[(i+1)%len(available)]
+	}
+
+	if len(configured) > 0 && configured[0] == next {
+		return configured
+	}
+	return []config.SortKey{next}
+}
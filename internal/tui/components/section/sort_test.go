@@ -0,0 +1,117 @@
+package section
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type sortTestRow struct {
+	name string
+	SortableRow
+}
+
+func sortedNames(rows []sortTestRow, keys ...config.SortKey) []string {
+	SortRows(rows, keys, func(r sortTestRow) SortableRow { return r.SortableRow })
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		names = append(names, r.name)
+	}
+	return names
+}
+
+func TestSortRows(t *testing.T) {
+	now := time.Now()
+	rows := func() []sortTestRow {
+		return []sortTestRow{
+			{name: "failing-small", SortableRow: SortableRow{
+				ChecksState: "FAILURE", LinesChanged: 2, Comments: 1, Repo: "b/repo",
+				Author: "zed", UpdatedAt: now, CreatedAt: now.Add(-time.Hour),
+			}},
+			{name: "green-big", SortableRow: SortableRow{
+				ChecksState: "SUCCESS", LinesChanged: 500, ReviewDecision: "APPROVED",
+				Repo: "a/repo", Author: "Amy", UpdatedAt: now.Add(-time.Hour), CreatedAt: now,
+			}},
+			{name: "green-small", SortableRow: SortableRow{
+				ChecksState: "SUCCESS", LinesChanged: 10, ReviewDecision: "CHANGES_REQUESTED",
+				Comments: 7, Repo: "c/repo", Author: "bob", UpdatedAt: now.Add(-2 * time.Hour),
+				CreatedAt: now.Add(-2 * time.Hour),
+			}},
+			{name: "pending", SortableRow: SortableRow{
+				ChecksState: "PENDING", LinesChanged: 1, Repo: "a/repo", Author: "carl",
+				UpdatedAt: now.Add(-3 * time.Hour), CreatedAt: now.Add(-3 * time.Hour),
+			}},
+		}
+	}
+
+	tests := []struct {
+		name string
+		keys []config.SortKey
+		want []string
+	}{
+		{
+			name: "no keys keeps the search order",
+			want: []string{"failing-small", "green-big", "green-small", "pending"},
+		},
+		{
+			name: "smallest green PRs first",
+			keys: []config.SortKey{config.SortByChecks, config.SortByLinesChanged},
+			want: []string{"green-small", "green-big", "pending", "failing-small"},
+		},
+		{
+			name: "review decision",
+			keys: []config.SortKey{config.SortByReviewDecision},
+			want: []string{"green-big", "failing-small", "pending", "green-small"},
+		},
+		{
+			name: "most comments first",
+			keys: []config.SortKey{config.SortByComments},
+			want: []string{"green-small", "failing-small", "green-big", "pending"},
+		},
+		{
+			name: "repo with ties kept in search order",
+			keys: []config.SortKey{config.SortByRepo},
+			want: []string{"green-big", "pending", "failing-small", "green-small"},
+		},
+		{
+			name: "author ignores case",
+			keys: []config.SortKey{config.SortByAuthor},
+			want: []string{"green-big", "green-small", "pending", "failing-small"},
+		},
+		{
+			name: "newest first",
+			keys: []config.SortKey{config.SortByCreated},
+			want: []string{"green-big", "failing-small", "green-small", "pending"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, sortedNames(rows(), tt.keys...))
+		})
+	}
+}
+
+func TestNextSortKeys(t *testing.T) {
+	available := []config.SortKey{
+		config.SortByUpdated,
+		config.SortByChecks,
+		config.SortByLinesChanged,
+	}
+	configured := []config.SortKey{config.SortByChecks, config.SortByLinesChanged}
+
+	keys := NextSortKeys(nil, nil, available)
+	require.Equal(t, []config.SortKey{config.SortByChecks}, keys)
+
+	keys = NextSortKeys(nil, configured, available)
+	require.Equal(t, configured, keys, "the configured tie breakers should be kept")
+
+	keys = NextSortKeys(keys, configured, available)
+	require.Equal(t, []config.SortKey{config.SortByLinesChanged}, keys)
+
+	keys = NextSortKeys(keys, configured, available)
+	require.Equal(t, []config.SortKey{config.SortByUpdated}, keys, "the cycle should wrap")
+}
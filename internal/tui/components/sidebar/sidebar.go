@@ -119,6 +119,16 @@ func (m *Model) ScrollToBottom() {
 	m.viewport.GotoBottom()
 }
 
+// ScrollToLine scrolls the least amount needed for the given line of the
+// content to be visible
+func (m *Model) ScrollToLine(line int) {
+	if line < m.viewport.YOffset() {
+		m.viewport.SetYOffset(line)
+	} else if height := m.viewport.Height(); line >= m.viewport.YOffset()+height {
+		m.viewport.SetYOffset(line - height + 1)
+	}
+}
+
 func (m *Model) YOffset() int {
 	return m.viewport.YOffset()
 }
@@ -26,7 +26,32 @@ type Model struct {
 	loadingSpinner spinner.Model
 	dimensions     constants.Dimensions
 	rowsViewport   listviewport.Model
+	markedRows     map[int]bool
 	ContentHeight  int // Optional: override content height (0 = use default from config)
+	// groups split the rows into runs shown under foldable headers. When
+	// they're set, the viewport items are the headers and the rows of the
+	// unfolded groups.
+	groups          []Group
+	collapsedGroups map[string]bool
+	// filteredRows are the rows left shown by a filter, all the rows are
+	// shown when it's nil
+	filteredRows map[int]bool
+	// shownGroupSizes are how many rows of each group the filter leaves shown
+	shownGroupSizes []int
+	items           []item
+}
+
+// Group is a run of consecutive rows shown under a header with their count
+type Group struct {
+	Title string
+	Size  int
+}
+
+// item is a line of a grouped or filtered table, either a group header or a
+// row
+type item struct {
+	group int // -1 when the rows aren't grouped
+	rowId int // -1 for the group header
 }
 
 type Column struct {
@@ -120,36 +145,45 @@ func (m *Model) ResetCurrItem() {
 	m.rowsViewport.ResetCurrItem()
 }
 
+// GetCurrItem returns the index of the current row, or -1 when the current
+// item is a group header or no row is shown
 func (m *Model) GetCurrItem() int {
-	return m.rowsViewport.GetCurrItem()
+	currItem := m.rowsViewport.GetCurrItem()
+	if m.items == nil {
+		return currItem
+	}
+	if currItem < 0 || currItem >= len(m.items) {
+		return -1
+	}
+	return m.items[currItem].rowId
 }
 
 func (m *Model) PrevItem() int {
-	currItem := m.rowsViewport.PrevItem()
+	m.rowsViewport.PrevItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) NextItem() int {
-	currItem := m.rowsViewport.NextItem()
+	m.rowsViewport.NextItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) FirstItem() int {
-	currItem := m.rowsViewport.FirstItem()
+	m.rowsViewport.FirstItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) LastItem() int {
-	currItem := m.rowsViewport.LastItem()
+	m.rowsViewport.LastItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) cacheColumnWidths() {
@@ -166,8 +200,18 @@ func (m *Model) SyncViewPortContent() {
 	headerColumns := m.renderHeaderColumns()
 	m.cacheColumnWidths()
 	renderedRows := make([]string, 0, len(m.Rows))
-	for i := range m.Rows {
-		renderedRows = append(renderedRows, m.renderRow(i, headerColumns))
+	if m.items != nil {
+		for i, item := range m.items {
+			if item.rowId < 0 {
+				renderedRows = append(renderedRows, m.renderGroupHeader(item.group, i))
+				continue
+			}
+			renderedRows = append(renderedRows, m.renderRow(item.rowId, headerColumns))
+		}
+	} else {
+		for i := range m.Rows {
+			renderedRows = append(renderedRows, m.renderRow(i, headerColumns))
+		}
 	}
 
 	m.rowsViewport.SyncViewPort(
@@ -177,10 +221,119 @@ func (m *Model) SyncViewPortContent() {
 
 func (m *Model) SetRows(rows []Row) {
 	m.Rows = rows
-	m.rowsViewport.SetNumItems(len(m.Rows))
+	m.syncItems()
 	m.SyncViewPortContent()
 }
 
+// SetGroups groups the rows under headers, the group sizes adding up to the
+// number of rows. Groups keep being folded across calls by their title. Nil
+// groups show the rows as a flat list.
+func (m *Model) SetGroups(groups []Group) {
+	m.groups = groups
+	m.syncItems()
+	m.SyncViewPortContent()
+}
+
+// ToggleCurrGroup folds or unfolds the group of the current item, moving to
+// its header. It returns false when the rows aren't grouped.
+func (m *Model) ToggleCurrGroup() (title string, collapsed bool, ok bool) {
+	currItem := m.rowsViewport.GetCurrItem()
+	if m.groups == nil || currItem < 0 || currItem >= len(m.items) {
+		return "", false, false
+	}
+
+	for m.items[currItem].rowId >= 0 {
+		currItem = m.rowsViewport.PrevItem()
+	}
+	title = m.groups[m.items[currItem].group].Title
+	if m.collapsedGroups == nil {
+		m.collapsedGroups = make(map[string]bool)
+	}
+	collapsed = !m.collapsedGroups[title]
+	m.collapsedGroups[title] = collapsed
+	m.syncItems()
+	m.SyncViewPortContent()
+
+	return title, collapsed, true
+}
+
+// CollapsedGroups returns the titles of the folded groups
+func (m *Model) CollapsedGroups() map[string]bool {
+	return m.collapsedGroups
+}
+
+// SetCollapsedGroups folds the groups with the titles, e.g. to keep them
+// folded in a recreated table
+func (m *Model) SetCollapsedGroups(collapsed map[string]bool) {
+	m.collapsedGroups = collapsed
+}
+
+// SetFilteredRows only shows the given rows, keeping their order and groups.
+// Groups without any of the rows are hidden. Nil rows show all the rows.
+func (m *Model) SetFilteredRows(rows map[int]bool) {
+	m.filteredRows = rows
+	m.syncItems()
+	m.SyncViewPortContent()
+}
+
+// IsFiltered is true while only some of the rows are shown
+func (m *Model) IsFiltered() bool {
+	return m.filteredRows != nil
+}
+
+// IsRowShown is false for the rows hidden by the filter
+func (m *Model) IsRowShown(rowId int) bool {
+	return m.filteredRows == nil || m.filteredRows[rowId]
+}
+
+// syncItems lays out the group headers and the shown rows of the unfolded
+// groups
+func (m *Model) syncItems() {
+	if m.groups == nil && m.filteredRows == nil {
+		m.items = nil
+		m.rowsViewport.SetNumItems(len(m.Rows))
+		return
+	}
+
+	m.items = make([]item, 0, len(m.groups)+len(m.Rows))
+	if m.groups == nil {
+		for j := range m.Rows {
+			if m.IsRowShown(j) {
+				m.items = append(m.items, item{group: -1, rowId: j})
+			}
+		}
+	}
+	m.shownGroupSizes = make([]int, len(m.groups))
+	rowId := 0
+	for i, group := range m.groups {
+		var rows []item
+		for j := rowId; j < rowId+group.Size && j < len(m.Rows); j++ {
+			if m.IsRowShown(j) {
+				rows = append(rows, item{group: i, rowId: j})
+			}
+		}
+		rowId += group.Size
+		m.shownGroupSizes[i] = len(rows)
+		if rows == nil && m.filteredRows != nil {
+			continue
+		}
+		m.items = append(m.items, item{group: i, rowId: -1})
+		if !m.collapsedGroups[group.Title] {
+			m.items = append(m.items, rows...)
+		}
+	}
+	m.rowsViewport.SetNumItems(len(m.items))
+	if len(m.items) > 0 && m.rowsViewport.GetCurrItem() >= len(m.items) {
+		m.rowsViewport.LastItem()
+	}
+}
+
+// SetMarkedRows sets the rows selected for bulk actions. They're rendered
+// with a check in place of their first column.
+func (m *Model) SetMarkedRows(rows map[int]bool) {
+	m.markedRows = rows
+}
+
 // SetContentHeight sets a custom content height for rows (use 0 to use default config-based height)
 func (m *Model) SetContentHeight(height int) {
 	m.ContentHeight = height
@@ -298,7 +451,7 @@ func (m *Model) renderBody() string {
 		)
 	}
 
-	if len(m.Rows) == 0 && m.EmptyState != nil {
+	if (len(m.Rows) == 0 || m.items != nil && len(m.items) == 0) && m.EmptyState != nil {
 		return bodyStyle.Render(*m.EmptyState)
 	}
 
@@ -308,8 +461,11 @@ func (m *Model) renderBody() string {
 func (m *Model) renderRow(rowId int, headerColumns []string) string {
 	var style lipgloss.Style
 
-	if m.rowsViewport.GetCurrItem() == rowId {
+	isMarked := m.markedRows[rowId]
+	if m.GetCurrItem() == rowId {
 		style = m.ctx.Styles.Table.SelectedCellStyle
+	} else if isMarked {
+		style = m.ctx.Styles.Table.MarkedCellStyle
 	} else {
 		style = m.ctx.Styles.Table.CellStyle
 	}
@@ -331,6 +487,9 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 			colHeight = 2
 		}
 		col := m.Rows[rowId][i]
+		if isMarked && i == 0 {
+			col = m.ctx.Styles.Table.MarkedIconStyle.Render(constants.CheckedIcon)
+		}
 		// For multi-line content, truncate long lines and pad short lines
 		// so lines don't wrap and background color extends properly
 		// Account for cell padding (1 left + 1 right = 2)
@@ -375,6 +534,41 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 		Render(lipgloss.JoinHorizontal(lipgloss.Top, renderedColumns...))
 }
 
+func (m *Model) renderGroupHeader(groupId int, itemId int) string {
+	group := m.groups[groupId]
+	style := m.ctx.Styles.Table.GroupHeaderStyle
+	if m.rowsViewport.GetCurrItem() == itemId {
+		style = m.ctx.Styles.Table.SelectedGroupHeaderStyle
+	}
+
+	icon := constants.ExpandedGroupIcon
+	if m.collapsedGroups[group.Title] {
+		icon = constants.CollapsedGroupIcon
+	}
+	height := 1
+	if m.ContentHeight > 0 {
+		height = m.ContentHeight
+	} else if !m.ctx.Config.Theme.Ui.Table.Compact {
+		height = 2
+	}
+	size := group.Size
+	if m.filteredRows != nil && groupId < len(m.shownGroupSizes) {
+		size = m.shownGroupSizes[groupId]
+	}
+	header := fmt.Sprintf("%s %s %s", icon, group.Title,
+		lipgloss.NewStyle().Faint(true).Render(fmt.Sprintf("(%d)", size)))
+
+	return m.ctx.Styles.Table.RowStyle.
+		BorderBottom(m.ctx.Config.Theme.Ui.Table.ShowSeparator).
+		MaxWidth(m.dimensions.Width).
+		Render(style.
+			Width(m.dimensions.Width).
+			MaxWidth(m.dimensions.Width).
+			Height(height).
+			MaxHeight(height).
+			Render(header))
+}
+
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	m.ctx = *ctx
 	m.rowsViewport.UpdateProgramContext(ctx)
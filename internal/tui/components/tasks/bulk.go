@@ -0,0 +1,365 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// bulkStep runs an action on a single item of a bulk task and returns the
+// message that updates the section with its result
+type bulkStep struct {
+	number int
+	run    func() (tea.Msg, error)
+}
+
+// BulkTask runs the same action over several PRs or issues, one after the
+// other, and reports its progress as a single task in the footer
+type BulkTask struct {
+	Id      string
+	Section SectionIdentifier
+	// Verb describes the action while it's running, e.g. "Closing"
+	Verb string
+	// PastVerb describes the action once it's done, e.g. "closed"
+	PastVerb string
+	Noun     string
+}
+
+func (t BulkTask) progressText(done, total int) string {
+	return fmt.Sprintf("%s %s %d/%d", t.Verb, t.Noun, done, total)
+}
+
+func (t BulkTask) finishedText(total int) string {
+	return fmt.Sprintf("%d %s have been %s", total, t.Noun, t.PastVerb)
+}
+
+func ghStep(task GitHubTask, number int) bulkStep {
+	return bulkStep{
+		number: number,
+		run: func() (tea.Msg, error) {
+			log.Info("Running task", "cmd", "gh "+strings.Join(task.Args, " "))
+			c := exec.Command("gh", task.Args...)
+			err := c.Run()
+			return task.Msg(c, err), err
+		},
+	}
+}
+
+func fireBulkTask(ctx *context.ProgramContext, task BulkTask, steps []bulkStep) tea.Cmd {
+	if len(steps) == 0 {
+		return nil
+	}
+
+	start := context.Task{
+		Id:           task.Id,
+		StartText:    task.progressText(0, len(steps)),
+		FinishedText: task.finishedText(len(steps)),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(start)
+	return tea.Batch(startCmd, runBulkStep(task, steps, 0, nil))
+}
+
+func runBulkStep(task BulkTask, steps []bulkStep, i int, failed []string) tea.Cmd {
+	return func() tea.Msg {
+		step := steps[i]
+		msg, err := step.run()
+		if err != nil {
+			log.Error("Bulk task step failed", "id", task.Id, "number", step.number, "err", err)
+			failed = append(failed, fmt.Sprintf("#%d", step.number))
+		}
+
+		if i < len(steps)-1 {
+			return constants.TaskProgressMsg{
+				TaskId:      task.Id,
+				SectionId:   task.Section.Id,
+				SectionType: task.Section.Type,
+				Text:        task.progressText(i+1, len(steps)),
+				Msg:         msg,
+				Next:        runBulkStep(task, steps, i+1, failed),
+			}
+		}
+
+		var bulkErr error
+		if len(failed) > 0 {
+			bulkErr = fmt.Errorf(
+				"%d/%d %s have been %s, failed on %s",
+				len(steps)-len(failed),
+				len(steps),
+				task.Noun,
+				task.PastVerb,
+				strings.Join(failed, ", "),
+			)
+		}
+		return constants.TaskFinishedMsg{
+			TaskId:      task.Id,
+			SectionId:   task.Section.Id,
+			SectionType: task.Section.Type,
+			Err:         bulkErr,
+			Msg:         msg,
+		}
+	}
+}
+
+func buildBulkTaskId(prefix string, rows []data.RowData) string {
+	return fmt.Sprintf("bulk_%s_%d_%d", prefix, len(rows), rows[0].GetNumber())
+}
+
+func fireGitHubBulkTask(
+	ctx *context.ProgramContext,
+	task BulkTask,
+	rows []data.RowData,
+	build func(row data.RowData) GitHubTask,
+) tea.Cmd {
+	steps := make([]bulkStep, 0, len(rows))
+	for _, row := range rows {
+		steps = append(steps, ghStep(build(row), row.GetNumber()))
+	}
+	return fireBulkTask(ctx, task, steps)
+}
+
+func ClosePRs(ctx *context.ProgramContext, section SectionIdentifier, prs []data.RowData) tea.Cmd {
+	if len(prs) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("pr_close", prs),
+		Section:  section,
+		Verb:     "Closing",
+		PastVerb: "closed",
+		Noun:     "PRs",
+	}, prs, func(pr data.RowData) GitHubTask {
+		return closePRTask(section, pr)
+	})
+}
+
+func ReopenPRs(ctx *context.ProgramContext, section SectionIdentifier, prs []data.RowData) tea.Cmd {
+	if len(prs) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("pr_reopen", prs),
+		Section:  section,
+		Verb:     "Reopening",
+		PastVerb: "reopened",
+		Noun:     "PRs",
+	}, prs, func(pr data.RowData) GitHubTask {
+		return reopenPRTask(section, pr)
+	})
+}
+
+func AssignPRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	usernames []string,
+) tea.Cmd {
+	if len(prs) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("pr_assign", prs),
+		Section:  section,
+		Verb:     "Assigning",
+		PastVerb: fmt.Sprintf("assigned to %s", usernames),
+		Noun:     "PRs",
+	}, prs, func(pr data.RowData) GitHubTask {
+		return assignPRTask(section, pr, usernames)
+	})
+}
+
+func ApprovePRs(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.RowData,
+	comment string,
+) tea.Cmd {
+	if len(prs) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("pr_approve", prs),
+		Section:  section,
+		Verb:     "Approving",
+		PastVerb: "approved",
+		Noun:     "PRs",
+	}, prs, func(pr data.RowData) GitHubTask {
+		return approvePRTask(section, pr, comment)
+	})
+}
+
+// AddPRLabels adds the labels to each of the PRs, keeping the labels they
+// already have
+func AddPRLabels(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prs []data.PullRequestData,
+	labels []string,
+) tea.Cmd {
+	if len(prs) == 0 {
+		return nil
+	}
+	rows := make([]data.RowData, 0, len(prs))
+	existingLabels := make(map[string][]data.Label, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, pr)
+		existingLabels[pr.Url] = pr.Labels.Nodes
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("pr_label", rows),
+		Section:  section,
+		Verb:     "Labeling",
+		PastVerb: fmt.Sprintf("labeled with %s", labels),
+		Noun:     "PRs",
+	}, rows, func(pr data.RowData) GitHubTask {
+		existing := existingLabels[pr.GetUrl()]
+		return labelPRTask(section, pr, mergeLabels(existing, labels), existing)
+	})
+}
+
+// MergePRs merges each of the PRs with the merge method configured for its
+// repo
+func MergePRs(ctx *context.ProgramContext, section SectionIdentifier, prs []data.RowData) tea.Cmd {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	steps := make([]bulkStep, 0, len(prs))
+	for _, pr := range prs {
+		prNumber := pr.GetNumber()
+		prUrl := pr.GetUrl()
+		repoName := pr.GetRepoNameWithOwner()
+		steps = append(steps, bulkStep{
+			number: prNumber,
+			run: func() (tea.Msg, error) {
+				err := data.MergePullRequest(prUrl, data.MergeOptions{
+					Method: ctx.Config.GetMergeMethod(repoName),
+				})
+				return UpdatePRMsg{
+					PrNumber: prNumber,
+					IsMerged: utils.BoolPtr(err == nil),
+				}, err
+			},
+		})
+	}
+
+	return fireBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("merge", prs),
+		Section:  section,
+		Verb:     "Merging",
+		PastVerb: "merged",
+		Noun:     "PRs",
+	}, steps)
+}
+
+func CloseIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+) tea.Cmd {
+	if len(issues) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("issue_close", issues),
+		Section:  section,
+		Verb:     "Closing",
+		PastVerb: "closed",
+		Noun:     "issues",
+	}, issues, func(issue data.RowData) GitHubTask {
+		return closeIssueTask(section, issue)
+	})
+}
+
+func ReopenIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+) tea.Cmd {
+	if len(issues) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("issue_reopen", issues),
+		Section:  section,
+		Verb:     "Reopening",
+		PastVerb: "reopened",
+		Noun:     "issues",
+	}, issues, func(issue data.RowData) GitHubTask {
+		return reopenIssueTask(section, issue)
+	})
+}
+
+func AssignIssues(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.RowData,
+	usernames []string,
+) tea.Cmd {
+	if len(issues) == 0 {
+		return nil
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("issue_assign", issues),
+		Section:  section,
+		Verb:     "Assigning",
+		PastVerb: fmt.Sprintf("assigned to %s", usernames),
+		Noun:     "issues",
+	}, issues, func(issue data.RowData) GitHubTask {
+		return assignIssueTask(section, issue, usernames)
+	})
+}
+
+// AddIssueLabels adds the labels to each of the issues, keeping the labels
+// they already have
+func AddIssueLabels(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issues []data.IssueData,
+	labels []string,
+) tea.Cmd {
+	if len(issues) == 0 {
+		return nil
+	}
+	rows := make([]data.RowData, 0, len(issues))
+	existingLabels := make(map[string][]data.Label, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, issue)
+		existingLabels[issue.Url] = issue.Labels.Nodes
+	}
+	return fireGitHubBulkTask(ctx, BulkTask{
+		Id:       buildBulkTaskId("issue_label", rows),
+		Section:  section,
+		Verb:     "Labeling",
+		PastVerb: fmt.Sprintf("labeled with %s", labels),
+		Noun:     "issues",
+	}, rows, func(issue data.RowData) GitHubTask {
+		existing := existingLabels[issue.GetUrl()]
+		return labelIssueTask(section, issue, mergeLabels(existing, labels), existing)
+	})
+}
+
+// mergeLabels returns the names of the existing labels followed by the added
+// labels that aren't already there
+func mergeLabels(existing []data.Label, added []string) []string {
+	labels := make([]string, 0, len(existing)+len(added))
+	for _, label := range existing {
+		labels = append(labels, label.Name)
+	}
+	for _, label := range added {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
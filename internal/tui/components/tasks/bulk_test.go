@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func fakeStep(number int, err error) bulkStep {
+	return bulkStep{
+		number: number,
+		run: func() (tea.Msg, error) {
+			return UpdatePRMsg{PrNumber: number}, err
+		},
+	}
+}
+
+func TestBulkTask_ReportsProgressThenFinishes(t *testing.T) {
+	task := BulkTask{
+		Id:       "bulk_pr_close_3_1",
+		Section:  SectionIdentifier{Id: 1, Type: "pr"},
+		Verb:     "Closing",
+		PastVerb: "closed",
+		Noun:     "PRs",
+	}
+	steps := []bulkStep{
+		fakeStep(1, nil),
+		fakeStep(2, errors.New("boom")),
+		fakeStep(3, nil),
+	}
+
+	msg := runBulkStep(task, steps, 0, nil)()
+	progress, ok := msg.(constants.TaskProgressMsg)
+	require.True(t, ok, "first step should report progress")
+	require.Equal(t, "Closing PRs 1/3", progress.Text)
+	require.Equal(t, UpdatePRMsg{PrNumber: 1}, progress.Msg)
+
+	progress = progress.Next().(constants.TaskProgressMsg)
+	require.Equal(t, "Closing PRs 2/3", progress.Text)
+
+	finished, ok := progress.Next().(constants.TaskFinishedMsg)
+	require.True(t, ok, "last step should finish the task")
+	require.Equal(t, task.Id, finished.TaskId)
+	require.Equal(t, 1, finished.SectionId)
+	require.EqualError(t, finished.Err, "2/3 PRs have been closed, failed on #2")
+	require.Equal(t, UpdatePRMsg{PrNumber: 3}, finished.Msg)
+}
+
+func TestBulkTask_StartsSingleTask(t *testing.T) {
+	var started []context.Task
+	ctx := &context.ProgramContext{
+		StartTask: func(task context.Task) tea.Cmd {
+			started = append(started, task)
+			return nil
+		},
+	}
+	prs := []data.RowData{
+		mockIssue{number: 7, repoName: "owner/repo"},
+		mockIssue{number: 9, repoName: "owner/repo"},
+	}
+
+	cmd := ClosePRs(ctx, SectionIdentifier{Id: 1, Type: "pr"}, prs)
+
+	require.NotNil(t, cmd)
+	require.Len(t, started, 1)
+	require.Equal(t, "bulk_pr_close_2_7", started[0].Id)
+	require.Equal(t, "Closing PRs 0/2", started[0].StartText)
+	require.Equal(t, "2 PRs have been closed", started[0].FinishedText)
+}
+
+func TestBulkTask_NothingSelected(t *testing.T) {
+	ctx := &context.ProgramContext{StartTask: noopStartTask}
+
+	require.Nil(t, ClosePRs(ctx, SectionIdentifier{}, nil))
+	require.Nil(t, AddIssueLabels(ctx, SectionIdentifier{}, nil, []string{"bug"}))
+}
+
+func TestMergeLabels_KeepsExistingLabels(t *testing.T) {
+	existing := []data.Label{{Name: "bug"}, {Name: "ui"}}
+
+	labels := mergeLabels(existing, []string{"ui", "p1"})
+
+	require.Equal(t, []string{"bug", "ui", "p1"}, labels)
+}
@@ -0,0 +1,131 @@
+package tasks
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+type UpdateDiscussionMsg struct {
+	DiscussionUrl string
+	NewComment    *data.DiscussionComment
+	// ReplyToId is the comment NewComment replies to, it's empty for comments
+	// on the discussion itself
+	ReplyToId string
+	// AnswerId is the comment marked as the answer, it's empty if the answer
+	// was unmarked
+	AnswerId *string
+}
+
+// CommentOnDiscussion comments on a discussion, or replies to one of its
+// comments when replyToId isn't empty
+func CommentOnDiscussion(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	discussion data.DiscussionData,
+	replyToId string,
+	body string,
+) tea.Cmd {
+	taskId := buildTaskId("discussion_comment", discussion.Number)
+	startText := fmt.Sprintf("Commenting on discussion #%d", discussion.Number)
+	finishedText := fmt.Sprintf("Commented on discussion #%d", discussion.Number)
+	if replyToId != "" {
+		taskId = buildTaskId("discussion_reply", discussion.Number)
+		startText = fmt.Sprintf("Replying on discussion #%d", discussion.Number)
+		finishedText = fmt.Sprintf("Replied on discussion #%d", discussion.Number)
+	}
+
+	task := context.Task{
+		Id:           taskId,
+		StartText:    startText,
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		comment, err := data.AddDiscussionComment(
+			discussion.Id,
+			replyToId,
+			body,
+			data.HostOf(discussion.Url),
+		)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				TaskId:      taskId,
+				SectionId:   section.Id,
+				SectionType: section.Type,
+				Err:         err,
+			}
+		}
+
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Msg: UpdateDiscussionMsg{
+				DiscussionUrl: discussion.Url,
+				NewComment:    &comment,
+				ReplyToId:     replyToId,
+			},
+		}
+	})
+}
+
+// SetDiscussionAnswer marks the comment as the answer of the discussion, or
+// unmarks it
+func SetDiscussionAnswer(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	discussion data.DiscussionData,
+	commentId string,
+	isAnswer bool,
+) tea.Cmd {
+	taskId := buildTaskId("discussion_answer", discussion.Number)
+	startText := fmt.Sprintf("Marking the answer of discussion #%d", discussion.Number)
+	finishedText := fmt.Sprintf("Discussion #%d has been answered", discussion.Number)
+	if !isAnswer {
+		startText = fmt.Sprintf("Unmarking the answer of discussion #%d", discussion.Number)
+		finishedText = fmt.Sprintf("Discussion #%d has been unanswered", discussion.Number)
+	}
+
+	task := context.Task{
+		Id:           taskId,
+		StartText:    startText,
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.SetDiscussionAnswer(commentId, isAnswer, data.HostOf(discussion.Url))
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				TaskId:      taskId,
+				SectionId:   section.Id,
+				SectionType: section.Type,
+				Err:         err,
+			}
+		}
+
+		answerId := ""
+		if isAnswer {
+			answerId = commentId
+		}
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Msg: UpdateDiscussionMsg{
+				DiscussionUrl: discussion.Url,
+				AnswerId:      &answerId,
+			},
+		}
+	})
+}
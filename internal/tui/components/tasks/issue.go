@@ -21,20 +21,19 @@ type UpdateIssueMsg struct {
 	RemovedAssignees *data.Assignees
 }
 
-func CloseIssue(
-	ctx *context.ProgramContext,
+func closeIssueTask(
 	section SectionIdentifier,
 	issue data.RowData,
-) tea.Cmd {
+) GitHubTask {
 	issueNumber := issue.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: fmt.Sprintf("issue_close_%d", issueNumber),
 		Args: []string{
 			"issue",
 			"close",
 			fmt.Sprint(issueNumber),
 			"-R",
-			issue.GetRepoNameWithOwner(),
+			repoFlag(issue),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Closing issue #%d", issueNumber),
@@ -45,23 +44,30 @@ func CloseIssue(
 				IsClosed:    utils.BoolPtr(true),
 			}
 		},
-	})
+	}
 }
 
-func ReopenIssue(
+func CloseIssue(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	issue data.RowData,
 ) tea.Cmd {
+	return fireTask(ctx, closeIssueTask(section, issue))
+}
+
+func reopenIssueTask(
+	section SectionIdentifier,
+	issue data.RowData,
+) GitHubTask {
 	issueNumber := issue.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: fmt.Sprintf("issue_reopen_%d", issueNumber),
 		Args: []string{
 			"issue",
 			"reopen",
 			fmt.Sprint(issueNumber),
 			"-R",
-			issue.GetRepoNameWithOwner(),
+			repoFlag(issue),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Reopening issue #%d", issueNumber),
@@ -72,27 +78,34 @@ func ReopenIssue(
 				IsClosed:    utils.BoolPtr(false),
 			}
 		},
-	})
+	}
 }
 
-func AssignIssue(
+func ReopenIssue(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
 	issue data.RowData,
-	usernames []string,
 ) tea.Cmd {
+	return fireTask(ctx, reopenIssueTask(section, issue))
+}
+
+func assignIssueTask(
+	section SectionIdentifier,
+	issue data.RowData,
+	usernames []string,
+) GitHubTask {
 	issueNumber := issue.GetNumber()
 	args := []string{
 		"issue",
 		"edit",
 		fmt.Sprint(issueNumber),
 		"-R",
-		issue.GetRepoNameWithOwner(),
+		repoFlag(issue),
 	}
 	for _, assignee := range usernames {
 		args = append(args, "--add-assignee", assignee)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           fmt.Sprintf("issue_assign_%d", issueNumber),
 		Args:         args,
 		Section:      section,
@@ -111,7 +124,16 @@ func AssignIssue(
 				AddedAssignees: &returnedAssignees,
 			}
 		},
-	})
+	}
+}
+
+func AssignIssue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	usernames []string,
+) tea.Cmd {
+	return fireTask(ctx, assignIssueTask(section, issue, usernames))
 }
 
 func UnassignIssue(
@@ -126,7 +148,7 @@ func UnassignIssue(
 		"edit",
 		fmt.Sprint(issueNumber),
 		"-R",
-		issue.GetRepoNameWithOwner(),
+		repoFlag(issue),
 	}
 	for _, assignee := range usernames {
 		args = append(args, "--remove-assignee", assignee)
@@ -167,7 +189,7 @@ func CommentOnIssue(
 			"comment",
 			fmt.Sprint(issueNumber),
 			"-R",
-			issue.GetRepoNameWithOwner(),
+			repoFlag(issue),
 			"-b",
 			body,
 		},
@@ -187,20 +209,19 @@ func CommentOnIssue(
 	})
 }
 
-func LabelIssue(
-	ctx *context.ProgramContext,
+func labelIssueTask(
 	section SectionIdentifier,
 	issue data.RowData,
 	labels []string,
 	existingLabels []data.Label,
-) tea.Cmd {
+) GitHubTask {
 	issueNumber := issue.GetNumber()
 	args := []string{
 		"issue",
 		"edit",
 		fmt.Sprint(issueNumber),
 		"-R",
-		issue.GetRepoNameWithOwner(),
+		repoFlag(issue),
 	}
 
 	labelsMap := make(map[string]bool)
@@ -223,7 +244,7 @@ func LabelIssue(
 		args = append(args, "--add-label", label)
 	}
 
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           fmt.Sprintf("issue_label_%d", issueNumber),
 		Args:         args,
 		Section:      section,
@@ -242,5 +263,15 @@ func LabelIssue(
 				Labels:      &returnedLabels,
 			}
 		},
-	})
+	}
+}
+
+func LabelIssue(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	issue data.RowData,
+	labels []string,
+	existingLabels []data.Label,
+) tea.Cmd {
+	return fireTask(ctx, labelIssueTask(section, issue, labels, existingLabels))
 }
@@ -24,8 +24,12 @@ type UpdatePRMsg struct {
 	PrNumber         int
 	IsClosed         *bool
 	NewComment       *data.Comment
+	NewReviewThread  *data.ReviewThread
+	UpdatedThread    *data.ReviewThread
+	NewThreadReply   *data.ReviewThreadReply
 	ReadyForReview   *bool
 	IsMerged         *bool
+	IsInMergeQueue   *bool
 	AddedAssignees   *data.Assignees
 	RemovedAssignees *data.Assignees
 	Labels           *data.PRLabels
@@ -50,6 +54,16 @@ type GitHubTask struct {
 	Msg          func(c *exec.Cmd, err error) tea.Msg
 }
 
+// repoFlag is the value of the -R flag of the gh commands acting on row. The
+// repos of GitHub Enterprise Server hosts are prefixed with their host as gh
+// would look them up on the default one otherwise.
+func repoFlag(row data.RowData) string {
+	if host := data.HostOf(row.GetUrl()); host != "" {
+		return host + "/" + row.GetRepoNameWithOwner()
+	}
+	return row.GetRepoNameWithOwner()
+}
+
 func fireTask(ctx *context.ProgramContext, task GitHubTask) tea.Cmd {
 	start := context.Task{
 		Id:           task.Id,
@@ -95,16 +109,16 @@ func OpenBranchPR(ctx *context.ProgramContext, section SectionIdentifier, branch
 	})
 }
 
-func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+func reopenPRTask(section SectionIdentifier, pr data.RowData) GitHubTask {
 	prNumber := pr.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: buildTaskId("pr_reopen", prNumber),
 		Args: []string{
 			"pr",
 			"reopen",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			repoFlag(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Reopening PR #%d", prNumber),
@@ -115,19 +129,23 @@ func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Ro
 				IsClosed: utils.BoolPtr(false),
 			}
 		},
-	})
+	}
 }
 
-func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+	return fireTask(ctx, reopenPRTask(section, pr))
+}
+
+func closePRTask(section SectionIdentifier, pr data.RowData) GitHubTask {
 	prNumber := pr.GetNumber()
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id: buildTaskId("pr_close", prNumber),
 		Args: []string{
 			"pr",
 			"close",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			repoFlag(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Closing PR #%d", prNumber),
@@ -138,7 +156,11 @@ func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 				IsClosed: utils.BoolPtr(true),
 			}
 		},
-	})
+	}
+}
+
+func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+	return fireTask(ctx, closePRTask(section, pr))
 }
 
 func PRReady(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
@@ -150,7 +172,7 @@ func PRReady(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 			"ready",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			repoFlag(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Marking PR #%d as ready for review", prNumber),
@@ -164,18 +186,16 @@ func PRReady(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 	})
 }
 
-func MergePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+// MergePR merges the PR with the given options. When no merge method is given,
+// the one configured for the repo of the PR is used.
+func MergePR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	opts data.MergeOptions,
+) tea.Cmd {
 	prNumber := pr.GetNumber()
-	c := exec.Command(
-		"gh",
-		"pr",
-		"merge",
-		fmt.Sprint(prNumber),
-		"-R",
-		pr.GetRepoNameWithOwner(),
-	)
-
-	taskId := fmt.Sprintf("merge_%d", prNumber)
+	taskId := buildTaskId("merge", prNumber)
 	task := context.Task{
 		Id:           taskId,
 		StartText:    fmt.Sprintf("Merging PR #%d", prNumber),
@@ -185,9 +205,13 @@ func MergePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 	}
 	startCmd := ctx.StartTask(task)
 
-	return tea.Batch(startCmd, tea.ExecProcess(c, func(err error) tea.Msg {
-		isMerged := err == nil && c.ProcessState.ExitCode() == 0
-
+	prUrl := pr.GetUrl()
+	repoName := pr.GetRepoNameWithOwner()
+	return tea.Batch(startCmd, func() tea.Msg {
+		if opts.Method == "" {
+			opts.Method = ctx.Config.GetMergeMethod(repoName)
+		}
+		err := data.MergePullRequest(prUrl, opts)
 		return constants.TaskFinishedMsg{
 			SectionId:   section.Id,
 			SectionType: section.Type,
@@ -195,10 +219,68 @@ func MergePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 			Err:         err,
 			Msg: UpdatePRMsg{
 				PrNumber: prNumber,
-				IsMerged: &isMerged,
+				IsMerged: utils.BoolPtr(err == nil),
 			},
 		}
-	}))
+	})
+}
+
+func EnablePRAutoMerge(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	opts data.MergeOptions,
+) tea.Cmd {
+	prNumber := pr.GetNumber()
+	taskId := buildTaskId("auto_merge", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Enabling auto-merge for PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("PR #%d will be merged when ready", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	prUrl := pr.GetUrl()
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.EnablePullRequestAutoMerge(prUrl, opts)
+		return constants.TaskFinishedMsg{
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         UpdatePRMsg{PrNumber: prNumber},
+		}
+	})
+}
+
+func EnqueuePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+	prNumber := pr.GetNumber()
+	taskId := buildTaskId("enqueue", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Adding PR #%d to the merge queue", prNumber),
+		FinishedText: fmt.Sprintf("PR #%d has been added to the merge queue", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	prUrl := pr.GetUrl()
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.EnqueuePullRequest(prUrl)
+		return constants.TaskFinishedMsg{
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			TaskId:      taskId,
+			Err:         err,
+			Msg: UpdatePRMsg{
+				PrNumber:       prNumber,
+				IsInMergeQueue: utils.BoolPtr(err == nil),
+			},
+		}
+	})
 }
 
 func CreatePR(
@@ -249,7 +331,7 @@ func updatePRTask(section SectionIdentifier, pr data.RowData) GitHubTask {
 			"update-branch",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			repoFlag(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Updating PR #%d", prNumber),
@@ -266,24 +348,23 @@ func UpdatePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Ro
 	return fireTask(ctx, updatePRTask(section, pr))
 }
 
-func AssignPR(
-	ctx *context.ProgramContext,
+func assignPRTask(
 	section SectionIdentifier,
 	pr data.RowData,
 	usernames []string,
-) tea.Cmd {
+) GitHubTask {
 	prNumber := pr.GetNumber()
 	args := []string{
 		"pr",
 		"edit",
 		fmt.Sprint(prNumber),
 		"-R",
-		pr.GetRepoNameWithOwner(),
+		repoFlag(pr),
 	}
 	for _, assignee := range usernames {
 		args = append(args, "--add-assignee", assignee)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           buildTaskId("pr_assign", prNumber),
 		Args:         args,
 		Section:      section,
@@ -302,7 +383,16 @@ func AssignPR(
 				AddedAssignees: &returnedAssignees,
 			}
 		},
-	})
+	}
+}
+
+func AssignPR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	usernames []string,
+) tea.Cmd {
+	return fireTask(ctx, assignPRTask(section, pr, usernames))
 }
 
 func UnassignPR(
@@ -317,7 +407,7 @@ func UnassignPR(
 		"edit",
 		fmt.Sprint(prNumber),
 		"-R",
-		pr.GetRepoNameWithOwner(),
+		repoFlag(pr),
 	}
 	for _, assignee := range usernames {
 		args = append(args, "--remove-assignee", assignee)
@@ -344,6 +434,75 @@ func UnassignPR(
 	})
 }
 
+func labelPRTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	labels []string,
+	existingLabels []data.Label,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	args := []string{
+		"pr",
+		"edit",
+		fmt.Sprint(prNumber),
+		"-R",
+		repoFlag(pr),
+	}
+
+	labelsMap := make(map[string]bool)
+	for _, label := range labels {
+		labelsMap[label] = true
+	}
+
+	existingLabelsColorMap := make(map[string]string)
+	for _, label := range existingLabels {
+		existingLabelsColorMap[label.Name] = label.Color
+	}
+
+	for _, label := range existingLabels {
+		if _, ok := labelsMap[label.Name]; !ok {
+			args = append(args, "--remove-label", label.Name)
+		}
+	}
+
+	for _, label := range labels {
+		args = append(args, "--add-label", label)
+	}
+
+	return GitHubTask{
+		Id:           buildTaskId("pr_label", prNumber),
+		Args:         args,
+		Section:      section,
+		StartText:    fmt.Sprintf("Labeling pr #%d to %s", prNumber, labels),
+		FinishedText: fmt.Sprintf("pr #%d has been labeled with %s", prNumber, labels),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			returnedLabels := data.PRLabels{Nodes: []data.Label{}}
+			for _, label := range labels {
+				returnedLabels.Nodes = append(returnedLabels.Nodes, data.Label{
+					Name:  label,
+					Color: existingLabelsColorMap[label],
+				})
+			}
+			return UpdatePRMsg{
+				PrNumber: prNumber,
+				Labels:   &returnedLabels,
+			}
+		},
+	}
+}
+
+// LabelPR sets the labels of the PR, removing the existing labels that
+// aren't in labels
+func LabelPR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	labels []string,
+	existingLabels []data.Label,
+) tea.Cmd {
+	return fireTask(ctx, labelPRTask(section, pr, labels, existingLabels))
+}
+
 func CommentOnPR(
 	ctx *context.ProgramContext,
 	section SectionIdentifier,
@@ -358,7 +517,7 @@ func CommentOnPR(
 			"comment",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			repoFlag(pr),
 			"-b",
 			body,
 		},
@@ -378,25 +537,24 @@ func CommentOnPR(
 	})
 }
 
-func ApprovePR(
-	ctx *context.ProgramContext,
+func approvePRTask(
 	section SectionIdentifier,
 	pr data.RowData,
 	comment string,
-) tea.Cmd {
+) GitHubTask {
 	prNumber := pr.GetNumber()
 	args := []string{
 		"pr",
 		"review",
 		"-R",
-		pr.GetRepoNameWithOwner(),
+		repoFlag(pr),
 		fmt.Sprint(prNumber),
 		"--approve",
 	}
 	if comment != "" {
 		args = append(args, "--body", comment)
 	}
-	return fireTask(ctx, GitHubTask{
+	return GitHubTask{
 		Id:           buildTaskId("pr_approve", prNumber),
 		Args:         args,
 		Section:      section,
@@ -407,7 +565,16 @@ func ApprovePR(
 				PrNumber: prNumber,
 			}
 		},
-	})
+	}
+}
+
+func ApprovePR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	comment string,
+) tea.Cmd {
+	return fireTask(ctx, approvePRTask(section, pr, comment))
 }
 
 func ApproveWorkflows(
@@ -431,7 +598,7 @@ func ApproveWorkflows(
 	return tea.Batch(startCmd, func() tea.Msg {
 		// Step 1: Get head SHA
 		shaCmd := exec.Command("gh", "pr", "view", fmt.Sprint(prNumber),
-			"-R", repo, "--json", "headRefOid", "--jq", ".headRefOid")
+			"-R", repoFlag(pr), "--json", "headRefOid", "--jq", ".headRefOid")
 		shaOut, err := shaCmd.Output()
 		if err != nil {
 			return constants.TaskFinishedMsg{
@@ -501,3 +668,61 @@ func ApproveWorkflows(
 		}
 	})
 }
+
+// WorkflowRunAction is what is done to the workflow run of a check
+type WorkflowRunAction string
+
+const (
+	RerunFailedJobs WorkflowRunAction = "rerun_failed"
+	RerunAllJobs    WorkflowRunAction = "rerun"
+	CancelRun       WorkflowRunAction = "cancel"
+)
+
+func workflowRunTask(
+	section SectionIdentifier,
+	pr data.RowData,
+	runId int64,
+	action WorkflowRunAction,
+) GitHubTask {
+	prNumber := pr.GetNumber()
+	run := fmt.Sprint(runId)
+
+	var args []string
+	var startText, finishedText string
+	switch action {
+	case RerunFailedJobs:
+		args = []string{"run", "rerun", run, "--failed"}
+		startText = fmt.Sprintf("Re-running failed jobs of run %s", run)
+		finishedText = fmt.Sprintf("Failed jobs of run %s have been re-run", run)
+	case CancelRun:
+		args = []string{"run", "cancel", run}
+		startText = fmt.Sprintf("Cancelling run %s", run)
+		finishedText = fmt.Sprintf("Run %s has been cancelled", run)
+	default:
+		args = []string{"run", "rerun", run}
+		startText = fmt.Sprintf("Re-running run %s", run)
+		finishedText = fmt.Sprintf("Run %s has been re-run", run)
+	}
+
+	return GitHubTask{
+		Id:           fmt.Sprintf("pr_%s_%d_%s", action, prNumber, run),
+		Args:         append(args, "-R", repoFlag(pr)),
+		Section:      section,
+		StartText:    startText,
+		FinishedText: finishedText,
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			return UpdatePRMsg{PrNumber: prNumber}
+		},
+	}
+}
+
+// RunWorkflowAction re-runs or cancels a workflow run of the PR's checks
+func RunWorkflowAction(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	runId int64,
+	action WorkflowRunAction,
+) tea.Cmd {
+	return fireTask(ctx, workflowRunTask(section, pr, runId, action))
+}
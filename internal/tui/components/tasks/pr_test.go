@@ -165,3 +165,60 @@ func TestApproveWorkflows_SectionIdentifierPropagation(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflowRunTask_Args(t *testing.T) {
+	pr := mockIssue{number: 42, repoName: "owner/repo"}
+	tests := []struct {
+		action WorkflowRunAction
+		id     string
+		args   []string
+	}{
+		{
+			action: RerunFailedJobs,
+			id:     "pr_rerun_failed_42_123",
+			args:   []string{"run", "rerun", "123", "--failed", "-R", "owner/repo"},
+		},
+		{
+			action: RerunAllJobs,
+			id:     "pr_rerun_42_123",
+			args:   []string{"run", "rerun", "123", "-R", "owner/repo"},
+		},
+		{
+			action: CancelRun,
+			id:     "pr_cancel_42_123",
+			args:   []string{"run", "cancel", "123", "-R", "owner/repo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			task := workflowRunTask(SectionIdentifier{Id: 2, Type: "pr"}, pr, 123, tt.action)
+
+			require.Equal(t, tt.id, task.Id)
+			require.Equal(t, tt.args, task.Args)
+			require.Equal(t, UpdatePRMsg{PrNumber: 42}, task.Msg(nil, nil))
+		})
+	}
+}
+
+func TestUpdatePR_EnterpriseRepo(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+
+	task := updatePRTask(SectionIdentifier{Id: 2, Type: "pr"}, mockIssue{
+		number:   42,
+		repoName: "owner/repo",
+		url:      "https://ghe.example.com/owner/repo/pull/42",
+	})
+	require.Equal(
+		t,
+		[]string{"pr", "update-branch", "42", "-R", "ghe.example.com/owner/repo"},
+		task.Args,
+	)
+
+	task = updatePRTask(SectionIdentifier{Id: 2, Type: "pr"}, mockIssue{
+		number:   42,
+		repoName: "owner/repo",
+		url:      "https://github.com/owner/repo/pull/42",
+	})
+	require.Equal(t, []string{"pr", "update-branch", "42", "-R", "owner/repo"}, task.Args)
+}
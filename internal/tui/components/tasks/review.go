@@ -0,0 +1,197 @@
+package tasks
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// AddPRReviewComment adds an inline review comment to a PR. When addToReview is
+// true the comment is added to the viewer's pending review, otherwise it's
+// posted right away as a single comment.
+func AddPRReviewComment(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prUrl string,
+	prId string,
+	prNumber int,
+	thread data.NewReviewThread,
+	addToReview bool,
+) tea.Cmd {
+	taskId := buildTaskId("pr_review_comment", prNumber)
+	finishedText := fmt.Sprintf("Commented on %s in PR #%d", thread.Path, prNumber)
+	if addToReview {
+		finishedText = fmt.Sprintf("Added comment on %s to your pending review", thread.Path)
+	}
+
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Commenting on %s in PR #%d", thread.Path, prNumber),
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		newThread, err := data.AddPullRequestReviewThread(
+			prId,
+			thread,
+			!addToReview,
+			data.HostOf(prUrl),
+		)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				TaskId:      taskId,
+				SectionId:   section.Id,
+				SectionType: section.Type,
+				Err:         err,
+				Msg:         UpdatePRMsg{PrNumber: prNumber},
+			}
+		}
+
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Msg: UpdatePRMsg{
+				PrNumber:        prNumber,
+				NewReviewThread: &newThread,
+			},
+		}
+	})
+}
+
+func SubmitPRReview(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prUrl string,
+	prId string,
+	prNumber int,
+	event data.ReviewEvent,
+	body string,
+) tea.Cmd {
+	taskId := buildTaskId("pr_review", prNumber)
+	finishedText := fmt.Sprintf("Reviewed PR #%d", prNumber)
+	switch event {
+	case data.ReviewEventApprove:
+		finishedText = fmt.Sprintf("PR #%d has been approved", prNumber)
+	case data.ReviewEventRequestChanges:
+		finishedText = fmt.Sprintf("Requested changes on PR #%d", prNumber)
+	}
+
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Submitting review on PR #%d", prNumber),
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.SubmitReview(prId, event, body, data.HostOf(prUrl))
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Err:         err,
+			Msg:         UpdatePRMsg{PrNumber: prNumber},
+		}
+	})
+}
+
+func ReplyToPRReviewThread(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prUrl string,
+	prNumber int,
+	threadId string,
+	body string,
+) tea.Cmd {
+	taskId := buildTaskId("pr_thread_reply", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Replying to review thread on PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("Replied to review thread on PR #%d", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		reply, err := data.ReplyToReviewThread(threadId, body, data.HostOf(prUrl))
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				TaskId:      taskId,
+				SectionId:   section.Id,
+				SectionType: section.Type,
+				Err:         err,
+				Msg:         UpdatePRMsg{PrNumber: prNumber},
+			}
+		}
+
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Msg: UpdatePRMsg{
+				PrNumber:       prNumber,
+				NewThreadReply: &reply,
+			},
+		}
+	})
+}
+
+func SetPRReviewThreadResolved(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	prUrl string,
+	prNumber int,
+	threadId string,
+	resolved bool,
+) tea.Cmd {
+	taskId := buildTaskId("pr_thread_resolve", prNumber)
+	startText := fmt.Sprintf("Resolving review thread on PR #%d", prNumber)
+	finishedText := fmt.Sprintf("Resolved review thread on PR #%d", prNumber)
+	if !resolved {
+		startText = fmt.Sprintf("Unresolving review thread on PR #%d", prNumber)
+		finishedText = fmt.Sprintf("Unresolved review thread on PR #%d", prNumber)
+	}
+
+	task := context.Task{
+		Id:           taskId,
+		StartText:    startText,
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		thread, err := data.SetReviewThreadResolved(threadId, resolved, data.HostOf(prUrl))
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				TaskId:      taskId,
+				SectionId:   section.Id,
+				SectionType: section.Type,
+				Err:         err,
+				Msg:         UpdatePRMsg{PrNumber: prNumber},
+			}
+		}
+
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Msg: UpdatePRMsg{
+				PrNumber:      prNumber,
+				UpdatedThread: &thread,
+			},
+		}
+	})
+}
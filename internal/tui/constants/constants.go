@@ -40,6 +40,7 @@ const (
 	TeamIcon             = ""
 	WaitingIcon          = ""
 	ActionRequiredIcon   = "" // nf-cod-warning (matches GitHub UI)
+	NoticeIcon           = "" // \uf449 nf-oct-info
 
 	BehindIcon         = "󰇮"
 	BlockedIcon        = ""
@@ -56,6 +57,12 @@ const (
 	MergeQueueIcon     = "" // \uf4db nf-oct-git_merge_queue
 	OpenIcon           = ""
 	SelectionIcon      = "→"
+	ExpandedGroupIcon  = "" // \uf47c nf-oct-chevron_down
+	CollapsedGroupIcon = "" // \uf460 nf-oct-chevron_right
+	CheckedIcon        = "󰄲"
+	DiscussionIcon     = "" // \uf442 nf-oct-comment_discussion
+	AnsweredIcon       = "" // \uf058 nf-fa-circle_check
+	RateLimitIcon      = "󰊚" // \udb80\ude9a nf-md-gauge
 
 	AutocompleteColumnGap              = 2
 	AutocompleteMinValueWidth          = 8
@@ -89,11 +96,20 @@ const (
 	SearchIcon       = "" // \uf002 nf-fa-search
 
 	// Prompts
-	AssignPrompt   = "Assign users (whitespace-separated)" + Ellipsis
-	UnassignPrompt = "Unassign users (whitespace-separated)" + Ellipsis
-	CommentPrompt  = "Leave a comment" + Ellipsis
-	ApprovalPrompt = "Approve with comment" + Ellipsis
-	LabelPrompt    = "Add/remove labels (comma-separated)" + Ellipsis
+	AssignPrompt    = "Assign users (whitespace-separated)" + Ellipsis
+	UnassignPrompt  = "Unassign users (whitespace-separated)" + Ellipsis
+	CommentPrompt   = "Leave a comment" + Ellipsis
+	ApprovalPrompt  = "Approve with comment" + Ellipsis
+	LabelPrompt     = "Add/remove labels (comma-separated)" + Ellipsis
+	AddLabelsPrompt = "Add labels to the selected rows (comma-separated)" + Ellipsis
+
+	ReviewCommentPrompt        = "Leave a review comment" + Ellipsis
+	PendingReviewCommentPrompt = "Add a comment to your review" + Ellipsis
+	RequestChangesReviewPrompt = "Request changes" + Ellipsis
+	CommentReviewPrompt        = "Review comment" + Ellipsis
+	ThreadReplyPrompt          = "Reply to the thread" + Ellipsis
+	DiscussionReplyPrompt      = "Reply to the comment" + Ellipsis
+	MergeMessagePrompt         = "Commit title on the first line, then the body" + Ellipsis
 
 	Logo = `▜▔▚▐▔▌▚▔▐ ▌
 ▟▁▞▐▔▌▁▚▐▔▌`
@@ -13,3 +13,15 @@ type TaskFinishedMsg struct {
 type ClearTaskMsg struct {
 	TaskId string
 }
+
+// TaskProgressMsg reports that a task running over several items finished
+// one of them. Msg updates the section with the result of that item and Next
+// continues with the remaining items.
+type TaskProgressMsg struct {
+	TaskId      string
+	SectionId   int
+	SectionType string
+	Text        string
+	Msg         tea.Msg
+	Next        tea.Cmd
+}
@@ -35,6 +35,7 @@ type ProgramContext struct {
 	GitRepo              *gitm.Repository
 	RepoPath             string
 	RepoUrl              string
+	RepoScope            string // owner/repo all sections are scoped to with --repo
 	User                 string
 	ScreenHeight         int
 	ScreenWidth          int
@@ -79,6 +80,10 @@ func (ctx *ProgramContext) GetViewSectionsConfig() []config.SectionConfig {
 		for _, cfg := range ctx.Config.IssuesSections {
 			configs = append(configs, cfg.ToSectionConfig())
 		}
+	case config.DiscussionsView:
+		for _, cfg := range ctx.Config.DiscussionsSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
 	}
 
 	return append([]config.SectionConfig{{Title: ""}}, configs...)
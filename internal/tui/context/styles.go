@@ -79,8 +79,12 @@ type Styles struct {
 	Table struct {
 		CellStyle                lipgloss.Style
 		SelectedCellStyle        lipgloss.Style
+		MarkedCellStyle          lipgloss.Style
+		MarkedIconStyle          lipgloss.Style
 		TitleCellStyle           lipgloss.Style
 		SingleRuneTitleCellStyle lipgloss.Style
+		GroupHeaderStyle         lipgloss.Style
+		SelectedGroupHeaderStyle lipgloss.Style
 		HeaderStyle              lipgloss.Style
 		RowStyle                 lipgloss.Style
 	}
@@ -220,11 +224,19 @@ func InitStyles(theme theme.Theme) Styles {
 		MaxHeight(1)
 	s.Table.SelectedCellStyle = s.Table.CellStyle.
 		Background(theme.SelectedBackground)
+	s.Table.MarkedCellStyle = s.Table.CellStyle.
+		Background(theme.FaintBorder)
+	s.Table.MarkedIconStyle = lipgloss.NewStyle().
+		Foreground(theme.PrimaryText).
+		Bold(true)
 	s.Table.TitleCellStyle = s.Table.CellStyle.
 		Bold(true).
 		Foreground(theme.PrimaryText)
 	s.Table.SingleRuneTitleCellStyle = s.Table.TitleCellStyle.
 		Width(common.SingleRuneWidth)
+	s.Table.GroupHeaderStyle = s.Table.TitleCellStyle
+	s.Table.SelectedGroupHeaderStyle = s.Table.GroupHeaderStyle.
+		Background(theme.SelectedBackground)
 	s.Table.HeaderStyle = lipgloss.NewStyle()
 	s.Table.RowStyle = lipgloss.NewStyle().
 		BorderStyle(lipgloss.NormalBorder()).
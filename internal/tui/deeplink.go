@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/auth"
+	"github.com/cli/go-gh/v2/pkg/repository"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+)
+
+type deepLinkKind int
+
+const (
+	deepLinkUnknown deepLinkKind = iota
+	deepLinkPr
+	deepLinkIssue
+)
+
+// DeepLink is a PR or an issue to open gh-dash on
+type DeepLink struct {
+	Host   string
+	Owner  string
+	Repo   string
+	Number int
+	kind   deepLinkKind
+}
+
+var (
+	repoRefRegex   = regexp.MustCompile(`^([\w.-]+)/([\w.-]+)#(\d+)$`)
+	numberRefRegex = regexp.MustCompile(`^#(\d+)$`)
+)
+
+// ParseDeepLink parses a PR or issue URL, an `owner/repo#123` reference or a
+// `#123` reference to a PR or issue in the current repo
+func ParseDeepLink(arg string, currRepo repository.Repository) (DeepLink, error) {
+	arg = strings.TrimSpace(arg)
+
+	if matches := repoRefRegex.FindStringSubmatch(arg); matches != nil {
+		number, _ := strconv.Atoi(matches[3])
+		return DeepLink{
+			Host:   refHost(currRepo),
+			Owner:  matches[1],
+			Repo:   matches[2],
+			Number: number,
+		}, nil
+	}
+
+	if matches := numberRefRegex.FindStringSubmatch(arg); matches != nil {
+		if currRepo.Owner == "" || currRepo.Name == "" {
+			return DeepLink{}, fmt.Errorf(
+				"can't resolve %s outside of a GitHub repo, use owner/repo%s instead", arg, arg)
+		}
+		number, _ := strconv.Atoi(matches[1])
+		return DeepLink{
+			Host:   refHost(currRepo),
+			Owner:  currRepo.Owner,
+			Repo:   currRepo.Name,
+			Number: number,
+		}, nil
+	}
+
+	u, err := url.Parse(arg)
+	if err != nil || u.Host == "" {
+		return DeepLink{}, fmt.Errorf(
+			"%q is not a PR or issue URL, an owner/repo#123 reference or a #123 reference", arg)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 4 {
+		return DeepLink{}, fmt.Errorf("%q is not a PR or issue URL", arg)
+	}
+	number, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return DeepLink{}, fmt.Errorf("%q is not a PR or issue URL", arg)
+	}
+
+	link := DeepLink{Host: u.Host, Owner: parts[0], Repo: parts[1], Number: number}
+	switch parts[2] {
+	case "pull":
+		link.kind = deepLinkPr
+	case "issues":
+		link.kind = deepLinkIssue
+	default:
+		return DeepLink{}, fmt.Errorf("%q is not a PR or issue URL", arg)
+	}
+	return link, nil
+}
+
+// refHost is the host of a reference without one, the current repo's host or
+// else the host gh uses by default
+func refHost(currRepo repository.Repository) string {
+	if currRepo.Host != "" {
+		return currRepo.Host
+	}
+	host, _ := auth.DefaultHost()
+	return host
+}
+
+func (link DeepLink) String() string {
+	return fmt.Sprintf("%s/%s#%d", link.Owner, link.Repo, link.Number)
+}
+
+// FetchDeepLink fetches the PR or issue of the link as a row the sidebar can
+// show
+func FetchDeepLink(link DeepLink) (data.RowData, error) {
+	var resourceUrl string
+	switch link.kind {
+	case deepLinkPr:
+		resourceUrl = fmt.Sprintf("https://%s/%s/%s/pull/%d", link.Host, link.Owner, link.Repo, link.Number)
+	case deepLinkIssue:
+		resourceUrl = fmt.Sprintf("https://%s/%s/%s/issues/%d", link.Host, link.Owner, link.Repo, link.Number)
+	default:
+		var isPr bool
+		var err error
+		resourceUrl, isPr, err = data.FetchIssueOrPullRequestUrl(
+			link.Owner,
+			link.Repo,
+			link.Number,
+			link.Host,
+		)
+		if err != nil {
+			return nil, err
+		}
+		link.kind = deepLinkIssue
+		if isPr {
+			link.kind = deepLinkPr
+		}
+	}
+
+	if link.kind == deepLinkPr {
+		pr, err := data.FetchPullRequest(resourceUrl)
+		if err != nil {
+			return nil, err
+		}
+		if pr.Number == 0 {
+			return nil, fmt.Errorf("PR %s not found", link)
+		}
+		prData := pr.ToPullRequestData()
+		return &prrow.Data{Primary: &prData, Enriched: pr, IsEnriched: true}, nil
+	}
+
+	issue, err := data.FetchIssue(resourceUrl)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Number == 0 {
+		return nil, fmt.Errorf("issue %s not found", link)
+	}
+	return &issue, nil
+}
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeepLink(t *testing.T) {
+	currRepo := repository.Repository{Host: "github.com", Owner: "dlvhdr", Name: "gh-dash"}
+
+	tests := []struct {
+		name string
+		arg  string
+		want DeepLink
+	}{
+		{
+			name: "PR URL",
+			arg:  "https://github.com/dlvhdr/gh-dash/pull/123",
+			want: DeepLink{Host: "github.com", Owner: "dlvhdr", Repo: "gh-dash", Number: 123, kind: deepLinkPr},
+		},
+		{
+			name: "PR URL with a tab",
+			arg:  "https://github.com/dlvhdr/gh-dash/pull/123/files#diff-1",
+			want: DeepLink{Host: "github.com", Owner: "dlvhdr", Repo: "gh-dash", Number: 123, kind: deepLinkPr},
+		},
+		{
+			name: "issue URL on an enterprise host",
+			arg:  "https://github.example.com/org/repo/issues/7",
+			want: DeepLink{Host: "github.example.com", Owner: "org", Repo: "repo", Number: 7, kind: deepLinkIssue},
+		},
+		{
+			name: "repo reference",
+			arg:  "charmbracelet/bubbletea#42",
+			want: DeepLink{Host: "github.com", Owner: "charmbracelet", Repo: "bubbletea", Number: 42},
+		},
+		{
+			name: "number reference",
+			arg:  "#9",
+			want: DeepLink{Host: "github.com", Owner: "dlvhdr", Repo: "gh-dash", Number: 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDeepLink(tt.arg, currRepo)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseDeepLink_RepoReferenceHost(t *testing.T) {
+	enterpriseRepo := repository.Repository{Host: "github.example.com", Owner: "org", Name: "app"}
+	got, err := ParseDeepLink("org/lib#3", enterpriseRepo)
+	require.NoError(t, err)
+	require.Equal(t, "github.example.com", got.Host, "the reference should be on the current repo's host")
+
+	// Outside of a repo, the reference is on the host gh uses by default
+	t.Setenv("GH_HOST", "github.other.com")
+	got, err = ParseDeepLink("org/lib#3", repository.Repository{})
+	require.NoError(t, err)
+	require.Equal(t, "github.other.com", got.Host)
+}
+
+func TestParseDeepLink_Invalid(t *testing.T) {
+	for _, arg := range []string{
+		"not a link",
+		"https://github.com/dlvhdr/gh-dash",
+		"https://github.com/dlvhdr/gh-dash/discussions/1",
+		"https://github.com/dlvhdr/gh-dash/pull/abc",
+	} {
+		_, err := ParseDeepLink(arg, repository.Repository{})
+		require.Error(t, err, arg)
+	}
+
+	_, err := ParseDeepLink("#9", repository.Repository{})
+	require.ErrorContains(t, err, "outside of a GitHub repo")
+}
@@ -0,0 +1,121 @@
+package keys
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	log "charm.land/log/v2"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type DiscussionKeyMap struct {
+	Comment  key.Binding
+	Comments key.Binding
+	ViewPRs  key.Binding
+}
+
+var DiscussionKeys = DiscussionKeyMap{
+	Comment: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "comment"),
+	),
+	Comments: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "reply/answer"),
+	),
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func DiscussionFullHelp() []key.Binding {
+	return []key.Binding{
+		DiscussionKeys.Comment,
+		DiscussionKeys.Comments,
+		DiscussionKeys.ViewPRs,
+	}
+}
+
+// DiscussionCommentKeyMap holds the keys used while navigating the comments
+// of a discussion
+type DiscussionCommentKeyMap struct {
+	Up           key.Binding
+	Down         key.Binding
+	Reply        key.Binding
+	ToggleAnswer key.Binding
+	Back         key.Binding
+}
+
+var DiscussionCommentKeys = DiscussionCommentKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "previous comment"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "next comment"),
+	),
+	Reply: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "reply"),
+	),
+	ToggleAnswer: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "mark/unmark as answer"),
+	),
+	Back: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "back"),
+	),
+}
+
+func rebindDiscussionKeys(keys []config.Keybinding) error {
+	CustomDiscussionBindings = []key.Binding{}
+
+	for _, discussionKey := range keys {
+		if discussionKey.Builtin == "" {
+			// Handle custom commands
+			if discussionKey.Command != "" {
+				name := discussionKey.Name
+				if discussionKey.Name == "" {
+					name = config.TruncateCommand(discussionKey.Command)
+				}
+
+				customBinding := key.NewBinding(
+					key.WithKeys(discussionKey.Key),
+					key.WithHelp(discussionKey.Key, name),
+				)
+
+				CustomDiscussionBindings = append(CustomDiscussionBindings, customBinding)
+			}
+			continue
+		}
+
+		log.Debug("Rebinding discussion key", "builtin", discussionKey.Builtin, "key", discussionKey.Key)
+
+		var key *key.Binding
+
+		switch discussionKey.Builtin {
+		case "comment":
+			key = &DiscussionKeys.Comment
+		case "comments":
+			key = &DiscussionKeys.Comments
+		case "viewPrs":
+			key = &DiscussionKeys.ViewPRs
+		default:
+			return fmt.Errorf("unknown built-in discussion key: '%s'", discussionKey.Builtin)
+		}
+
+		key.SetKeys(discussionKey.Key)
+
+		helpDesc := key.Help().Desc
+		if discussionKey.Name != "" {
+			helpDesc = discussionKey.Name
+		}
+		key.SetHelp(discussionKey.Key, helpDesc)
+	}
+
+	return nil
+}
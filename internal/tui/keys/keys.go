@@ -33,6 +33,7 @@ type KeyMap struct {
 	Down                  key.Binding
 	FirstLine             key.Binding
 	LastLine              key.Binding
+	ToggleGroup           key.Binding
 	TogglePreview         key.Binding
 	TogglePreviewPosition key.Binding
 	OpenGithub            key.Binding
@@ -44,8 +45,15 @@ type KeyMap struct {
 	NextSection           key.Binding
 	PrevSection           key.Binding
 	Search                key.Binding
+	QuickFilter           key.Binding
+	SaveSection           key.Binding
 	CopyUrl               key.Binding
 	CopyNumber            key.Binding
+	ToggleSelection       key.Binding
+	SelectAll             key.Binding
+	SelectMatching        key.Binding
+	ClearSelection        key.Binding
+	CommandPalette        key.Binding
 	Help                  key.Binding
 	Quit                  key.Binding
 }
@@ -69,11 +77,14 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 
 	switch k.viewType {
 	case config.PRsView:
-		additionalKeys = PRFullHelp()
+		additionalKeys = append(PRFullHelp(), k.SelectionKeys()...)
 		customKeys = append(customKeys, CustomPRBindings...)
 	case config.RepoView:
 		additionalKeys = BranchFullHelp()
 		customKeys = append(customKeys, CustomBranchBindings...)
+	case config.DiscussionsView:
+		additionalKeys = DiscussionFullHelp()
+		customKeys = append(customKeys, CustomDiscussionBindings...)
 	case config.NotificationsView:
 		additionalKeys = NotificationFullHelp()
 		customKeys = append(customKeys, CustomNotificationBindings...)
@@ -87,7 +98,7 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 			customKeys = append(customKeys, CustomIssueBindings...)
 		}
 	default:
-		additionalKeys = IssueFullHelp()
+		additionalKeys = append(IssueFullHelp(), k.SelectionKeys()...)
 		customKeys = append(customKeys, CustomIssueBindings...)
 	}
 
@@ -114,6 +125,7 @@ func (k KeyMap) NavigationKeys() []key.Binding {
 		k.NextSection,
 		k.FirstLine,
 		k.LastLine,
+		k.ToggleGroup,
 		k.PageDown,
 		k.PageUp,
 	}
@@ -129,6 +141,20 @@ func (k KeyMap) AppKeys() []key.Binding {
 		k.CopyNumber,
 		k.CopyUrl,
 		k.Search,
+		k.QuickFilter,
+		k.SaveSection,
+		k.CommandPalette,
+	}
+}
+
+// SelectionKeys select rows to run an action over several PRs or issues at
+// once
+func (k KeyMap) SelectionKeys() []key.Binding {
+	return []key.Binding{
+		k.ToggleSelection,
+		k.SelectAll,
+		k.SelectMatching,
+		k.ClearSelection,
 	}
 }
 
@@ -153,6 +179,10 @@ var Keys = &KeyMap{
 		key.WithKeys("G", "end"),
 		key.WithHelp("G/end", "last item"),
 	),
+	ToggleGroup: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "fold group"),
+	),
 	TogglePreview: key.NewBinding(
 		key.WithKeys("p"),
 		key.WithHelp("p", "toggle preview"),
@@ -193,6 +223,14 @@ var Keys = &KeyMap{
 		key.WithKeys("/"),
 		key.WithHelp("/", "search"),
 	),
+	QuickFilter: key.NewBinding(
+		key.WithKeys("ctrl+f"),
+		key.WithHelp("Ctrl+f", "quick filter"),
+	),
+	SaveSection: key.NewBinding(
+		key.WithKeys("ctrl+s"),
+		key.WithHelp("Ctrl+s", "save search as section"),
+	),
 	CopyNumber: key.NewBinding(
 		key.WithKeys("y"),
 		key.WithHelp("y", "copy number"),
@@ -201,6 +239,26 @@ var Keys = &KeyMap{
 		key.WithKeys("Y"),
 		key.WithHelp("Y", "copy url"),
 	),
+	ToggleSelection: key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "toggle selection"),
+	),
+	SelectAll: key.NewBinding(
+		key.WithKeys("ctrl+a"),
+		key.WithHelp("Ctrl+a", "select all"),
+	),
+	SelectMatching: key.NewBinding(
+		key.WithKeys("*"),
+		key.WithHelp("*", "select matching"),
+	),
+	ClearSelection: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "clear selection"),
+	),
+	CommandPalette: key.NewBinding(
+		key.WithKeys(":"),
+		key.WithHelp(":", "command palette"),
+	),
 	Help: key.NewBinding(
 		key.WithKeys("?"),
 		key.WithHelp("?", "help"),
@@ -213,7 +271,8 @@ var Keys = &KeyMap{
 
 // Rebind will update our saved keybindings from configuration values.
 func Rebind(
-	universal, issueKeys, prKeys, branchKeys, notificationKeys, cmpKeys []config.Keybinding,
+	universal, issueKeys, prKeys, branchKeys, notificationKeys, discussionKeys,
+	cmpKeys []config.Keybinding,
 ) error {
 	err := rebindUniversal(universal)
 	if err != nil {
@@ -235,6 +294,11 @@ func Rebind(
 		return err
 	}
 
+	err = rebindDiscussionKeys(discussionKeys)
+	if err != nil {
+		return err
+	}
+
 	err = rebindCmpKeys(cmpKeys)
 	if err != nil {
 		return err
@@ -250,6 +314,7 @@ var (
 	CustomIssueBindings        []key.Binding
 	CustomBranchBindings       []key.Binding
 	CustomNotificationBindings []key.Binding
+	CustomDiscussionBindings   []key.Binding
 	CustomCmpBindings          []key.Binding
 )
 
@@ -290,6 +355,8 @@ func rebindUniversal(universal []config.Keybinding) error {
 			key = &Keys.FirstLine
 		case "lastLine":
 			key = &Keys.LastLine
+		case "toggleGroup":
+			key = &Keys.ToggleGroup
 		case "togglePreview":
 			key = &Keys.TogglePreview
 		case "togglePreviewPosition":
@@ -312,10 +379,24 @@ func rebindUniversal(universal []config.Keybinding) error {
 			key = &Keys.PrevSection
 		case "search":
 			key = &Keys.Search
+		case "quickFilter":
+			key = &Keys.QuickFilter
+		case "saveSection":
+			key = &Keys.SaveSection
 		case "copyurl":
 			key = &Keys.CopyUrl
 		case "copyNumber":
 			key = &Keys.CopyNumber
+		case "toggleSelection":
+			key = &Keys.ToggleSelection
+		case "selectAll":
+			key = &Keys.SelectAll
+		case "selectMatching":
+			key = &Keys.SelectMatching
+		case "clearSelection":
+			key = &Keys.ClearSelection
+		case "commandPalette":
+			key = &Keys.CommandPalette
 		case "help":
 			key = &Keys.Help
 		case "quit":
@@ -0,0 +1,57 @@
+package keys
+
+import (
+	"charm.land/bubbles/v2/key"
+)
+
+// MergeKeyMap holds the keys used to pick how a PR is merged
+type MergeKeyMap struct {
+	Merge        key.Binding
+	Squash       key.Binding
+	Rebase       key.Binding
+	DeleteBranch key.Binding
+	EditMessage  key.Binding
+	Submit       key.Binding
+	AutoMerge    key.Binding
+	Enqueue      key.Binding
+	Cancel       key.Binding
+}
+
+var MergeKeys = MergeKeyMap{
+	Merge: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "merge commit"),
+	),
+	Squash: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "squash"),
+	),
+	Rebase: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "rebase"),
+	),
+	DeleteBranch: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "delete branch"),
+	),
+	EditMessage: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "edit commit message"),
+	),
+	Submit: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "merge"),
+	),
+	AutoMerge: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "enable auto-merge"),
+	),
+	Enqueue: key.NewBinding(
+		key.WithKeys("q"),
+		key.WithHelp("q", "add to merge queue"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
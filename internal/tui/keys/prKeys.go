@@ -29,6 +29,11 @@ type PRKeyMap struct {
 	ApproveWorkflows     key.Binding
 	ToggleSmartFiltering key.Binding
 	ViewIssues           key.Binding
+	ReviewFiles          key.Binding
+	Review               key.Binding
+	ReviewThreads        key.Binding
+	InspectChecks        key.Binding
+	CycleSort            key.Binding
 }
 
 var PRKeys = PRKeyMap{
@@ -108,6 +113,26 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("s"),
 		key.WithHelp("s", "switch to issues"),
 	),
+	ReviewFiles: key.NewBinding(
+		key.WithKeys("F"),
+		key.WithHelp("F", "review changed files"),
+	),
+	Review: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "submit review"),
+	),
+	ReviewThreads: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "review threads"),
+	),
+	InspectChecks: key.NewBinding(
+		key.WithKeys("K"),
+		key.WithHelp("K", "inspect checks"),
+	),
+	CycleSort: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "cycle sort"),
+	),
 }
 
 func PRFullHelp() []key.Binding {
@@ -130,6 +155,11 @@ func PRFullHelp() []key.Binding {
 		PRKeys.ApproveWorkflows,
 		PRKeys.ToggleSmartFiltering,
 		PRKeys.ViewIssues,
+		PRKeys.ReviewFiles,
+		PRKeys.Review,
+		PRKeys.ReviewThreads,
+		PRKeys.InspectChecks,
+		PRKeys.CycleSort,
 	}
 }
 
@@ -196,6 +226,16 @@ func rebindPRKeys(keys []config.Keybinding) error {
 			key = &PRKeys.ViewIssues
 		case "summaryViewMore":
 			key = &PRKeys.SummaryViewMore
+		case "reviewFiles":
+			key = &PRKeys.ReviewFiles
+		case "review":
+			key = &PRKeys.Review
+		case "reviewThreads":
+			key = &PRKeys.ReviewThreads
+		case "inspectChecks":
+			key = &PRKeys.InspectChecks
+		case "cycleSort":
+			key = &PRKeys.CycleSort
 		default:
 			return fmt.Errorf("unknown built-in pr key: '%s'", prKey.Builtin)
 		}
@@ -0,0 +1,151 @@
+package keys
+
+import (
+	"charm.land/bubbles/v2/key"
+)
+
+// FileReviewKeyMap holds the keys used while navigating the files and patches
+// of the "Files Changed" tab of a PR
+type FileReviewKeyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	Open        key.Binding
+	Back        key.Binding
+	SelectRange key.Binding
+	Comment     key.Binding
+	AddToReview key.Binding
+}
+
+var FileReviewKeys = FileReviewKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Open: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "open file"),
+	),
+	Back: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "back"),
+	),
+	SelectRange: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "select range"),
+	),
+	Comment: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "comment"),
+	),
+	AddToReview: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "add to review"),
+	),
+}
+
+// ReviewEventKeyMap holds the keys used to pick how a PR review is submitted
+type ReviewEventKeyMap struct {
+	Approve        key.Binding
+	RequestChanges key.Binding
+	Comment        key.Binding
+	Cancel         key.Binding
+}
+
+var ReviewEventKeys = ReviewEventKeyMap{
+	Approve: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "approve"),
+	),
+	RequestChanges: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "request changes"),
+	),
+	Comment: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "comment"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// ReviewThreadKeyMap holds the keys used while navigating the review threads
+// of a PR
+type ReviewThreadKeyMap struct {
+	Up             key.Binding
+	Down           key.Binding
+	Reply          key.Binding
+	ToggleResolved key.Binding
+	Back           key.Binding
+}
+
+var ReviewThreadKeys = ReviewThreadKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "previous thread"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "next thread"),
+	),
+	Reply: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "reply"),
+	),
+	ToggleResolved: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "resolve/unresolve"),
+	),
+	Back: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "back"),
+	),
+}
+
+// CheckRunKeyMap holds the keys used while navigating the check runs of the
+// "Checks" tab of a PR
+type CheckRunKeyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	ViewLog     key.Binding
+	RerunFailed key.Binding
+	RerunAll    key.Binding
+	Cancel      key.Binding
+	Back        key.Binding
+}
+
+var CheckRunKeys = CheckRunKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "previous check"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "next check"),
+	),
+	ViewLog: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "view log"),
+	),
+	RerunFailed: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "re-run failed jobs"),
+	),
+	RerunAll: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "re-run all jobs"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "cancel run"),
+	),
+	Back: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "back"),
+	),
+}
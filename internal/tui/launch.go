@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// LaunchOptions override where gh-dash starts, they're set with the CLI flags
+type LaunchOptions struct {
+	// View overrides the configured defaults.view
+	View config.ViewType
+	// Section is the title or the 1-based index of the section to start on
+	Section string
+	// Repo scopes all sections to the owner/repo
+	Repo string
+}
+
+// SetLaunchOptions makes gh-dash start on the view and section of the
+// options, scoped to their repo
+func (m *Model) SetLaunchOptions(opts LaunchOptions) {
+	m.launchOptions = opts
+	m.ctx.RepoScope = opts.Repo
+}
+
+// applyLaunchView switches to the view of the launch options, if it's
+// available with the current config
+func (m *Model) applyLaunchView() error {
+	view := m.launchOptions.View
+	if view == "" {
+		return nil
+	}
+	if !slices.Contains(m.viewCycle(), view) {
+		return fmt.Errorf("the %s view isn't available with the current config", view)
+	}
+	m.ctx.View = view
+	return nil
+}
+
+// launchSectionId returns the id of the section of the launch options in the
+// current view, or the default section of the view if it's not set
+func (m *Model) launchSectionId() (int, error) {
+	ref := m.launchOptions.Section
+	if ref == "" {
+		return m.getCurrentViewDefaultSection(), nil
+	}
+
+	// The first section of every view is the search section
+	configs := m.ctx.GetViewSectionsConfig()
+	if index, err := strconv.Atoi(ref); err == nil {
+		if index >= 1 && index < len(configs) {
+			return index, nil
+		}
+		return m.getCurrentViewDefaultSection(), fmt.Errorf(
+			"section %d doesn't exist, the %s view has %d sections",
+			index, m.ctx.View, len(configs)-1)
+	}
+
+	for i := 1; i < len(configs); i++ {
+		if strings.EqualFold(configs[i].Title, ref) {
+			return i, nil
+		}
+	}
+	return m.getCurrentViewDefaultSection(), fmt.Errorf(
+		"no section titled %q in the %s view", ref, m.ctx.View)
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"slices"
+	"strings"
 	"text/template"
 	"time"
 
+	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	log "charm.land/log/v2"
@@ -22,6 +25,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 )
 
@@ -34,6 +38,9 @@ func (m *Model) getCurrSection() section.Section {
 }
 
 func (m *Model) getCurrRowData() data.RowData {
+	if m.deepLinkRow != nil {
+		return m.deepLinkRow
+	}
 	section := m.getCurrSection()
 	if section == nil {
 		return nil
@@ -41,6 +48,17 @@ func (m *Model) getCurrRowData() data.RowData {
 	return section.GetCurrRow()
 }
 
+// rowUrl returns the url of the row, or an empty string when there's none
+func rowUrl(row data.RowData) string {
+	if row == nil {
+		return ""
+	}
+	if v := reflect.ValueOf(row); v.Kind() == reflect.Pointer && v.IsNil() {
+		return ""
+	}
+	return row.GetUrl()
+}
+
 func (m *Model) getSectionAt(id int) section.Section {
 	sections := m.getCurrentViewSections()
 	if len(sections) <= id {
@@ -73,7 +91,7 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 		}
 
 		log.Info("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
-		return m.runCustomUniversalCommand(keybinding.Command)
+		return m.runCustomUniversalCommand(keybinding)
 	}
 
 	switch m.ctx.View {
@@ -85,7 +103,7 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 
 			switch data := currRowData.(type) {
 			case *data.IssueData:
-				return m.runCustomIssueCommand(keybinding.Command, data)
+				return m.runCustomIssueCommand(keybinding, data)
 			}
 		}
 	case config.PRsView:
@@ -98,7 +116,20 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 
 			switch data := currRowData.(type) {
 			case *prrow.Data:
-				return m.runCustomPRCommand(keybinding.Command, data)
+				return m.runCustomPRCommand(keybinding, data)
+			}
+		}
+	case config.DiscussionsView:
+		for _, keybinding := range m.ctx.Config.Keybindings.Discussions {
+			if keybinding.Key != key || keybinding.Command == "" {
+				continue
+			}
+
+			log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
+
+			switch data := currRowData.(type) {
+			case *data.DiscussionData:
+				return m.runCustomDiscussionCommand(keybinding, data)
 			}
 		}
 	case config.RepoView:
@@ -111,7 +142,7 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 
 			switch data := currRowData.(type) {
 			case *prrow.Data:
-				return m.runCustomBranchCommand(keybinding.Command, data)
+				return m.runCustomBranchCommand(keybinding, data)
 			}
 		}
 	case config.NotificationsView:
@@ -127,7 +158,7 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 				keybinding.Command,
 			)
 			if nData, ok := currRowData.(*notificationrow.Data); ok {
-				return m.runCustomNotificationCommand(keybinding.Command, nData)
+				return m.runCustomNotificationCommand(keybinding, nData)
 			}
 		}
 
@@ -145,7 +176,7 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 						"command",
 						keybinding.Command,
 					)
-					return m.runCustomNotificationPRCommand(keybinding.Command, nData)
+					return m.runCustomNotificationPRCommand(keybinding, nData)
 				}
 			case "Issue":
 				for _, keybinding := range m.ctx.Config.Keybindings.Issues {
@@ -159,7 +190,7 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 						"command",
 						keybinding.Command,
 					)
-					return m.runCustomNotificationIssueCommand(keybinding.Command, nData)
+					return m.runCustomNotificationIssueCommand(keybinding, nData)
 				}
 			}
 		}
@@ -207,12 +238,27 @@ func resolveTemplateInput(
 }
 
 // runCustomCommand executes a user-defined command.
-// commandTemplate is a template string that will be parsed with the input data.
+// The keybinding's command is a template string that will be parsed with the input data.
 // contextData is a map of key-value pairs of data specific to the context the command is being run in.
-func (m *Model) runCustomCommand(commandTemplate string, contextData *map[string]any) tea.Cmd {
+// The keybinding's prompts are asked first, their answers being added to the input data.
+func (m *Model) runCustomCommand(
+	keybinding config.Keybinding,
+	contextData *map[string]any,
+) tea.Cmd {
 	input := resolveTemplateInput(contextData, m.ctx.Config.RepoPaths, m.ctx.RepoPath)
+	if len(keybinding.Prompts) > 0 || keybinding.Confirm != "" {
+		return m.commandPrompt.Start(keybinding, input)
+	}
+	return m.executeCommandTemplate(keybinding, input)
+}
 
-	cmd, err := template.New("keybinding_command").Parse(commandTemplate)
+// executeCommandTemplate parses the keybinding's command with the input data
+// and executes it
+func (m *Model) executeCommandTemplate(keybinding config.Keybinding, input map[string]any) tea.Cmd {
+	commandTemplate := keybinding.Command
+	cmd, err := template.New("keybinding_command").
+		Funcs(template.FuncMap{"shellquote": shell.Quote}).
+		Parse(commandTemplate)
 	if err != nil {
 		log.Fatal("Failed parse keybinding template", "error", err)
 	}
@@ -228,11 +274,11 @@ func (m *Model) runCustomCommand(commandTemplate string, contextData *map[string
 			return constants.ErrMsg{Err: fmt.Errorf("failed to parsetemplate %s", commandTemplate)}
 		}
 	}
-	return m.executeCustomCommand(buff.String())
+	return m.executeCustomCommand(keybinding, buff.String())
 }
 
-func (m *Model) runCustomPRCommand(commandTemplate string, prData *prrow.Data) tea.Cmd {
-	return m.runCustomCommand(commandTemplate,
+func (m *Model) runCustomPRCommand(keybinding config.Keybinding, prData *prrow.Data) tea.Cmd {
+	return m.runCustomCommand(keybinding,
 		&map[string]any{
 			"RepoName":    prData.GetRepoNameWithOwner(),
 			"PrNumber":    prData.Primary.Number,
@@ -242,8 +288,11 @@ func (m *Model) runCustomPRCommand(commandTemplate string, prData *prrow.Data) t
 		})
 }
 
-func (m *Model) runCustomIssueCommand(commandTemplate string, issueData *data.IssueData) tea.Cmd {
-	return m.runCustomCommand(commandTemplate,
+func (m *Model) runCustomIssueCommand(
+	keybinding config.Keybinding,
+	issueData *data.IssueData,
+) tea.Cmd {
+	return m.runCustomCommand(keybinding,
 		&map[string]any{
 			"RepoName":    issueData.GetRepoNameWithOwner(),
 			"IssueNumber": issueData.Number,
@@ -253,9 +302,27 @@ func (m *Model) runCustomIssueCommand(commandTemplate string, issueData *data.Is
 	)
 }
 
-func (m *Model) runCustomBranchCommand(commandTemplate string, branchData *prrow.Data) tea.Cmd {
+func (m *Model) runCustomDiscussionCommand(
+	keybinding config.Keybinding,
+	discussionData *data.DiscussionData,
+) tea.Cmd {
+	return m.runCustomCommand(keybinding,
+		&map[string]any{
+			"RepoName":         discussionData.GetRepoNameWithOwner(),
+			"DiscussionNumber": discussionData.Number,
+			"DiscussionTitle":  discussionData.Title,
+			"Author":           discussionData.Author.Login,
+			"Url":              discussionData.Url,
+		},
+	)
+}
+
+func (m *Model) runCustomBranchCommand(
+	keybinding config.Keybinding,
+	branchData *prrow.Data,
+) tea.Cmd {
 	if reflect.ValueOf(branchData).IsNil() {
-		return m.executeCustomCommand(commandTemplate)
+		return m.executeCustomCommand(keybinding, keybinding.Command)
 	}
 	input := map[string]any{
 		"RepoPath": m.ctx.RepoPath,
@@ -270,16 +337,16 @@ func (m *Model) runCustomBranchCommand(commandTemplate string, branchData *prrow
 				"Author":      branchData.Primary.Author.Login,
 			})
 	}
-	return m.runCustomCommand(commandTemplate, &input)
+	return m.runCustomCommand(keybinding, &input)
 }
 
-func (m *Model) runCustomUniversalCommand(commandTemplate string) tea.Cmd {
+func (m *Model) runCustomUniversalCommand(keybinding config.Keybinding) tea.Cmd {
 	input := map[string]any{"RepoPath": m.ctx.RepoPath}
-	return m.runCustomCommand(commandTemplate, &input)
+	return m.runCustomCommand(keybinding, &input)
 }
 
 func (m *Model) runCustomNotificationPRCommand(
-	commandTemplate string,
+	keybinding config.Keybinding,
 	nData *notificationrow.Data,
 ) tea.Cmd {
 	fields := map[string]any{
@@ -291,11 +358,11 @@ func (m *Model) runCustomNotificationPRCommand(
 		fields["BaseRefName"] = pr.Primary.BaseRefName
 		fields["Author"] = pr.Primary.Author.Login
 	}
-	return m.runCustomCommand(commandTemplate, &fields)
+	return m.runCustomCommand(keybinding, &fields)
 }
 
 func (m *Model) runCustomNotificationIssueCommand(
-	commandTemplate string,
+	keybinding config.Keybinding,
 	nData *notificationrow.Data,
 ) tea.Cmd {
 	fields := map[string]any{
@@ -305,23 +372,68 @@ func (m *Model) runCustomNotificationIssueCommand(
 	if issue := m.notificationView.GetSubjectIssue(); issue != nil {
 		fields["Author"] = issue.Author.Login
 	}
-	return m.runCustomCommand(commandTemplate, &fields)
+	return m.runCustomCommand(keybinding, &fields)
 }
 
 func (m *Model) runCustomNotificationCommand(
-	commandTemplate string,
+	keybinding config.Keybinding,
 	nData *notificationrow.Data,
 ) tea.Cmd {
 	fields := map[string]any{
 		"RepoName": nData.GetRepoNameWithOwner(),
 		"Number":   nData.GetNumber(),
 	}
-	return m.runCustomCommand(commandTemplate, &fields)
+	return m.runCustomCommand(keybinding, &fields)
 }
 
 type execProcessFinishedMsg struct{}
 
-func (m *Model) executeCustomCommand(cmd string) tea.Cmd {
+// customCommandFinishedMsg is sent when a command that runs in the
+// background exits
+type customCommandFinishedMsg struct {
+	name   string
+	output config.CommandOutput
+	stdout string
+	err    error
+}
+
+// executeCustomCommand runs the command in the terminal, or in the background
+// when the keybinding captures its output
+func (m *Model) executeCustomCommand(keybinding config.Keybinding, cmd string) tea.Cmd {
+	if keybinding.IsInteractive() {
+		return m.execInteractiveCommand(cmd)
+	}
+
+	name := keybinding.Name
+	if name == "" {
+		name = config.TruncateCommand(cmd)
+	}
+
+	if keybinding.Output == config.OutputPane {
+		runCmd := m.commandOutput.Run(name, cmd)
+		if !m.sidebar.IsOpen {
+			m.sidebar.IsOpen = true
+			m.syncMainContentDimensions()
+		}
+		m.syncSidebar()
+		m.sidebar.ScrollToTop()
+		return runCmd
+	}
+
+	log.Debug("running custom command in the background", "cmd", cmd)
+	output := keybinding.Output
+	return func() tea.Msg {
+		stdout, err := shell.Command(cmd).CombinedOutput()
+		return customCommandFinishedMsg{
+			name:   name,
+			output: output,
+			stdout: string(stdout),
+			err:    err,
+		}
+	}
+}
+
+func (m *Model) execInteractiveCommand(cmd string) tea.Cmd {
 	log.Debug("executing custom command", "cmd", cmd)
 	c := shell.Command(cmd)
 	return tea.ExecProcess(c, func(err error) tea.Msg {
@@ -342,6 +454,54 @@ func (m *Model) executeCustomCommand(cmd string) tea.Cmd {
 	})
 }
 
+// onCustomCommandFinished notifies about a command that ran in the
+// background, showing the last line of its output when asked to
+func (m *Model) onCustomCommandFinished(msg customCommandFinishedMsg) tea.Cmd {
+	lastLine := lastNonEmptyLine(msg.stdout)
+	if msg.err != nil {
+		log.Error("custom command failed", "name", msg.name, "err", msg.err, "output", msg.stdout)
+		text := fmt.Sprintf("%s failed: %v", msg.name, msg.err)
+		if lastLine != "" {
+			text = fmt.Sprintf("%s failed: %s", msg.name, lastLine)
+		}
+		return m.notifyErr(text)
+	}
+
+	if msg.output != config.OutputNotify {
+		return nil
+	}
+	if lastLine == "" {
+		lastLine = fmt.Sprintf("%s done", msg.name)
+	}
+	return m.notify(lastLine)
+}
+
+func lastNonEmptyLine(s string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// cycleSort sorts the rows of the section by its next sort key and views the
+// first row
+func (m *Model) cycleSort(currSection section.Section) tea.Cmd {
+	sortable, ok := currSection.(section.Sortable)
+	if !ok {
+		return nil
+	}
+
+	sortKey := sortable.CycleSortKey()
+	currSection.FirstItem()
+	return tea.Batch(
+		m.onViewedRowChanged(),
+		m.notify(fmt.Sprintf("Sorting by %s", sortKey)),
+	)
+}
+
 func (m *Model) notify(text string) tea.Cmd {
 	id := fmt.Sprint(time.Now().Unix())
 	startCmd := m.ctx.StartTask(
@@ -380,3 +540,18 @@ func (m *Model) notifyErr(text string) tea.Cmd {
 
 	return tea.Sequence(startCmd, finishCmd)
 }
+
+// paletteBindings are the keybindings of the current view, built-in and
+// custom, that the command palette lists
+func (m *Model) paletteBindings() []key.Binding {
+	var bindings []key.Binding
+	for _, group := range keys.CreateKeyMapForView(m.ctx.View).FullHelp() {
+		for _, binding := range group {
+			if slices.Equal(binding.Keys(), m.keys.CommandPalette.Keys()) {
+				continue
+			}
+			bindings = append(bindings, binding)
+		}
+	}
+	return bindings
+}
@@ -28,6 +28,11 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branchsidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/commandoutput"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/commandpalette"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/commandprompt"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/footer"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issueview"
@@ -54,19 +59,28 @@ type Model struct {
 	sidebar          sidebar.Model
 	prView           prview.Model
 	issueSidebar     issueview.Model
+	discussionView   discussionview.Model
 	branchSidebar    branchsidebar.Model
 	notificationView notificationview.Model
+	commandPalette   commandpalette.Model
+	commandOutput    commandoutput.Model
+	commandPrompt    commandprompt.Model
 	currSectionId    int
 	footer           footer.Model
 	repo             section.Section
 	prs              []section.Section
 	issues           []section.Section
+	discussions      []section.Section
 	notifications    []section.Section
 	tabs             tabs.Model
 	ctx              *context.ProgramContext
 	taskSpinner      spinner.Model
 	tasks            map[string]context.Task
 	positionOverride string // "" means no override, "right" or "bottom"
+	// deepLinkRow is the PR or issue gh-dash was launched with, it's shown in
+	// the sidebar until another row is viewed
+	deepLinkRow   data.RowData
+	launchOptions LaunchOptions
 }
 
 type Repositories struct {
@@ -107,13 +121,23 @@ func NewModel(location config.Location, repos Repositories) Model {
 	m.footer = footer.NewModel(m.ctx)
 	m.prView = prview.NewModel(m.ctx)
 	m.issueSidebar = issueview.NewModel(m.ctx)
+	m.discussionView = discussionview.NewModel(m.ctx)
 	m.branchSidebar = branchsidebar.NewModel(m.ctx)
 	m.notificationView = notificationview.NewModel(m.ctx)
+	m.commandPalette = commandpalette.NewModel(m.ctx)
+	m.commandOutput = commandoutput.NewModel(m.ctx)
+	m.commandPrompt = commandprompt.NewModel(m.ctx)
 	m.tabs = tabs.NewModel(m.ctx)
 
 	return m
 }
 
+// SetDeepLinkRow makes gh-dash start with the PR or issue open in the sidebar,
+// see FetchDeepLink
+func (m *Model) SetDeepLinkRow(row data.RowData) {
+	m.deepLinkRow = row
+}
+
 func (m *Model) initScreen() tea.Msg {
 	showError := func(err error) {
 		styles := log.DefaultStyles()
@@ -163,6 +187,7 @@ func (m *Model) initScreen() tea.Msg {
 		cfg.Keybindings.Prs,
 		cfg.Keybindings.Branches,
 		cfg.Keybindings.Notifications,
+		cfg.Keybindings.Discussions,
 		cfg.Keybindings.Cmp,
 	)
 	if err != nil {
@@ -178,28 +203,63 @@ func (m Model) Init() tea.Cmd {
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var (
-		cmd             tea.Cmd
-		tabsCmd         tea.Cmd
-		sidebarCmd      tea.Cmd
-		prViewCmd       tea.Cmd
-		issueSidebarCmd tea.Cmd
-		footerCmd       tea.Cmd
-		cmds            []tea.Cmd
-		currSection     = m.getCurrSection()
-		currRowData     = m.getCurrRowData()
+		cmd               tea.Cmd
+		tabsCmd           tea.Cmd
+		sidebarCmd        tea.Cmd
+		prViewCmd         tea.Cmd
+		issueSidebarCmd   tea.Cmd
+		discussionViewCmd tea.Cmd
+		footerCmd         tea.Cmd
+		cmds              []tea.Cmd
+		currSection       = m.getCurrSection()
+		currRowData       = m.getCurrRowData()
 	)
+	selection, isSelectable := currSection.(section.Selection)
+	quickFilter, isQuickFilterable := currSection.(section.QuickFilterable)
+
+	if _, isKey := msg.(tea.KeyMsg); !isKey && m.commandPalette.IsOpen() {
+		var paletteCmd tea.Cmd
+		m.commandPalette, paletteCmd = m.commandPalette.Update(msg)
+		cmds = append(cmds, paletteCmd)
+	}
+
+	if _, isKey := msg.(tea.KeyMsg); !isKey && m.commandPrompt.IsOpen() {
+		var promptCmd tea.Cmd
+		m.commandPrompt, promptCmd = m.commandPrompt.Update(msg)
+		cmds = append(cmds, promptCmd)
+	}
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		log.Info("Key pressed", "key", msg.String())
 		m.ctx.Error = nil
 
+		if m.commandPalette.IsOpen() {
+			m.commandPalette, cmd = m.commandPalette.Update(msg)
+			return m, cmd
+		}
+
+		if m.commandPrompt.IsOpen() {
+			m.commandPrompt, cmd = m.commandPrompt.Update(msg)
+			return m, cmd
+		}
+
 		if currSection != nil && (currSection.IsSearchFocused() ||
 			currSection.IsPromptConfirmationFocused()) {
 			cmd = m.updateSection(currSection.GetId(), currSection.GetType(), msg)
 			return m, cmd
 		}
 
+		// The rows narrow down as the quick filter is typed, so the preview
+		// follows the current row
+		if isQuickFilterable && quickFilter.IsQuickFilterFocused() {
+			cmd = m.updateSection(currSection.GetId(), currSection.GetType(), msg)
+			if newRowData := m.getCurrRowData(); rowUrl(newRowData) != rowUrl(currRowData) {
+				cmd = tea.Batch(cmd, m.onViewedRowChanged())
+			}
+			return m, cmd
+		}
+
 		if m.prView.IsTextInputBoxFocused() {
 			m.prView, cmd = m.prView.Update(msg)
 			m.syncSidebar()
@@ -212,6 +272,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 
+		if m.discussionView.IsTextInputBoxFocused() {
+			m.discussionView, cmd = m.discussionView.Update(msg)
+			m.syncSidebar()
+			return m, cmd
+		}
+
+		if m.sidebar.IsOpen && m.ctx.View == config.DiscussionsView &&
+			m.discussionView.IsNavigatingComments() {
+			m.discussionView, cmd = m.discussionView.Update(msg)
+			m.syncSidebar()
+			m.sidebar.ScrollToLine(m.discussionView.CommentsCursorLine())
+			return m, cmd
+		}
+
+		if m.sidebar.IsOpen && m.ctx.View == config.PRsView &&
+			m.prView.IsReviewingFiles() {
+			m.prView, cmd = m.prView.Update(msg)
+			m.syncSidebar()
+			m.sidebar.ScrollToLine(m.prView.FilesCursorLine())
+			return m, cmd
+		}
+
+		if m.sidebar.IsOpen && m.ctx.View == config.PRsView &&
+			m.prView.IsNavigatingThreads() {
+			m.prView, cmd = m.prView.Update(msg)
+			m.syncSidebar()
+			m.sidebar.ScrollToLine(m.prView.ThreadsCursorLine())
+			return m, cmd
+		}
+
+		if m.sidebar.IsOpen && m.ctx.View == config.PRsView &&
+			m.prView.IsNavigatingChecks() {
+			m.prView, cmd = m.prView.Update(msg)
+			m.syncSidebar()
+			m.sidebar.ScrollToLine(m.prView.ChecksCursorLine())
+			return m, cmd
+		}
+
+		if m.sidebar.IsOpen && m.ctx.View == config.PRsView &&
+			m.prView.IsChoosingMergeOptions() {
+			m.prView, cmd = m.prView.Update(msg)
+			m.syncSidebar()
+			m.sidebar.ScrollToBottom()
+			return m, cmd
+		}
+
+		if m.sidebar.IsOpen && m.ctx.View == config.PRsView &&
+			m.prView.IsChoosingReviewEvent() {
+			m.prView, cmd = m.prView.Update(msg)
+			m.syncSidebar()
+			m.sidebar.ScrollToBottom()
+			return m, cmd
+		}
+
 		if m.footer.ShowConfirmQuit && (msg.String() == "y" || msg.String() == "enter") {
 			return m, tea.Quit
 		} else if m.footer.ShowConfirmQuit {
@@ -230,6 +344,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if m.commandOutput.IsShown() && msg.String() == "esc" {
+			m.commandOutput.Close()
+			return m, m.syncSidebar()
+		}
+
 		switch {
 		case m.isUserDefinedKeybinding(msg):
 			cmd = m.executeKeybinding(msg.String())
@@ -282,6 +401,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmd = m.onViewedRowChanged()
 			}
 
+		case key.Matches(msg, m.keys.ToggleGroup):
+			if groupable, ok := currSection.(section.Groupable); ok {
+				if _, _, ok := groupable.ToggleCurrGroup(); ok {
+					cmd = m.onViewedRowChanged()
+				}
+			}
+
 		case key.Matches(msg, m.keys.TogglePreview):
 			m.sidebar.IsOpen = !m.sidebar.IsOpen
 			m.syncMainContentDimensions()
@@ -325,6 +451,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, cmd
 			}
 
+		case key.Matches(msg, m.keys.QuickFilter) && isQuickFilterable:
+			cmd = quickFilter.SetIsQuickFiltering(true)
+			return m, cmd
+
+		case key.Matches(msg, m.keys.SaveSection) &&
+			(m.ctx.View == config.PRsView || m.ctx.View == config.IssuesView ||
+				m.ctx.View == config.NotificationsView):
+			cmd = m.promptConfirmation(currSection, "save_section")
+			return m, cmd
+
+		case key.Matches(msg, m.keys.CommandPalette):
+			cmd = m.commandPalette.Open(m.paletteBindings())
+			return m, cmd
+
 		case key.Matches(msg, m.keys.Help):
 			m.footer.ShowAll = !m.footer.ShowAll
 			m.syncMainContentDimensions()
@@ -359,6 +499,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, cmd
 
+		case key.Matches(msg, m.keys.ToggleSelection) && isSelectable:
+			selection.ToggleCurrRowSelection()
+			currSection.NextRow()
+			cmd = m.onViewedRowChanged()
+
+		case key.Matches(msg, m.keys.SelectAll) && isSelectable:
+			selection.SelectAllRows()
+
+		case key.Matches(msg, m.keys.SelectMatching) && isSelectable:
+			cmd = m.promptConfirmation(currSection, "select")
+			return m, cmd
+
+		case key.Matches(msg, m.keys.ClearSelection) && isSelectable &&
+			selection.NumSelected() > 0:
+			selection.ClearSelection()
+
+		case key.Matches(msg, m.keys.ClearSelection) && isQuickFilterable &&
+			quickFilter.GetQuickFilter() != "":
+			quickFilter.ClearQuickFilter()
+			cmd = m.onViewedRowChanged()
+
 		case key.Matches(msg, m.keys.Quit):
 			if !m.ctx.Config.ConfirmQuit {
 				return m, tea.Quit
@@ -410,16 +571,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, m.openBrowser())
 
 			case key.Matches(msg, keys.PRKeys.Approve):
-				return m, m.openSidebarForPRInput(m.prView.SetIsApproving)
+				return m, m.openSidebarForSelectionInput(m.prView.SetIsApproving)
+
+			case key.Matches(msg, keys.PRKeys.Review):
+				return m, m.openSidebarForPRInput(m.prView.SetIsReviewing)
 
 			case key.Matches(msg, keys.PRKeys.Assign):
-				return m, m.openSidebarForPRInput(m.prView.SetIsAssigning)
+				return m, m.openSidebarForSelectionInput(m.prView.SetIsAssigning)
 
 			case key.Matches(msg, keys.PRKeys.Unassign):
 				return m, m.openSidebarForPRInput(m.prView.SetIsUnassigning)
 
 			case key.Matches(msg, keys.PRKeys.Label):
-				return m, m.openSidebarForPRInput(m.prView.SetIsLabeling)
+				return m, m.openSidebarForSelectionInput(m.prView.SetIsLabeling)
 
 			case key.Matches(msg, keys.PRKeys.Comment):
 				return m, m.openSidebarForPRInput(m.prView.SetIsCommenting)
@@ -443,8 +607,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, cmd
 
 			case key.Matches(msg, keys.PRKeys.Merge):
-				if currRowData != nil {
+				// The merge composer is for a single PR, selected PRs are
+				// merged with the merge method configured for their repo
+				if s, ok := currSection.(section.Selection); ok && s.NumSelected() > 0 {
 					cmd = m.promptConfirmation(currSection, "merge")
+				} else if currRowData != nil {
+					cmd = m.openSidebarForPRInput(m.prView.SetIsMerging)
 				}
 				return m, cmd
 
@@ -463,10 +631,43 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case key.Matches(msg, keys.PRKeys.ViewIssues):
 				cmds = append(cmds, m.switchSelectedView())
 
+			case key.Matches(msg, keys.PRKeys.CycleSort):
+				return m, m.cycleSort(currSection)
+
 			case key.Matches(msg, keys.PRKeys.SummaryViewMore):
 				m.prView.SetSummaryViewMore()
 				m.syncSidebar()
 				return m, nil
+
+			case key.Matches(msg, keys.PRKeys.ReviewFiles):
+				if currRowData != nil {
+					m.sidebar.IsOpen = true
+					cmd = m.prView.StartReviewingFiles()
+					m.syncMainContentDimensions()
+					m.syncSidebar()
+					m.sidebar.ScrollToTop()
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.ReviewThreads):
+				if currRowData != nil {
+					m.sidebar.IsOpen = true
+					cmd = m.prView.StartNavigatingThreads()
+					m.syncMainContentDimensions()
+					m.syncSidebar()
+					m.sidebar.ScrollToLine(m.prView.ThreadsCursorLine())
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.InspectChecks):
+				if currRowData != nil {
+					m.sidebar.IsOpen = true
+					cmd = m.prView.StartNavigatingChecks()
+					m.syncMainContentDimensions()
+					m.syncSidebar()
+					m.sidebar.ScrollToLine(m.prView.ChecksCursorLine())
+				}
+				return m, cmd
 			}
 		case m.ctx.View == config.IssuesView:
 			switch {
@@ -474,10 +675,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, m.openBrowser())
 
 			case key.Matches(msg, keys.IssueKeys.Label):
-				return m, m.openSidebarForInput(m.issueSidebar.SetIsLabeling)
+				return m, m.openSidebarForSelectionInput(m.issueSidebar.SetIsLabeling)
 
 			case key.Matches(msg, keys.IssueKeys.Assign):
-				return m, m.openSidebarForInput(m.issueSidebar.SetIsAssigning)
+				return m, m.openSidebarForSelectionInput(m.issueSidebar.SetIsAssigning)
 
 			case key.Matches(msg, keys.IssueKeys.Unassign):
 				return m, m.openSidebarForInput(m.issueSidebar.SetIsUnassigning)
@@ -506,6 +707,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case key.Matches(msg, keys.IssueKeys.ViewPRs):
 				cmds = append(cmds, m.switchSelectedView())
+
+			case key.Matches(msg, keys.IssueKeys.CycleSort):
+				return m, m.cycleSort(currSection)
+			}
+		case m.ctx.View == config.DiscussionsView:
+			switch {
+			case key.Matches(msg, keys.DiscussionKeys.Comment):
+				return m, m.openSidebarForInput(m.discussionView.SetIsCommenting)
+
+			case key.Matches(msg, keys.DiscussionKeys.Comments):
+				if !m.sidebar.IsOpen {
+					m.sidebar.IsOpen = true
+					m.syncMainContentDimensions()
+				}
+				cmd = m.discussionView.StartNavigatingComments()
+				m.syncSidebar()
+				m.sidebar.ScrollToLine(m.discussionView.CommentsCursorLine())
+				return m, cmd
+
+			case key.Matches(msg, keys.DiscussionKeys.ViewPRs):
+				cmds = append(cmds, m.switchSelectedView())
 			}
 		case m.ctx.View == config.NotificationsView:
 			switch {
@@ -670,6 +892,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case commandpalette.RunActionMsg:
+		log.Info("Running action from the command palette", "action", msg.Action.Desc)
+		return m.Update(commandpalette.KeyPress(msg.Action.Key))
+
+	case commandprompt.SubmitMsg:
+		cmd = m.executeCommandTemplate(msg.Keybinding, msg.Input)
+
 	case initMsg:
 		m.ctx.Config = &msg.Config
 		m.ctx.RepoUrl = msg.RepoUrl
@@ -679,13 +908,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			Background(m.ctx.Theme.SelectedBackground)
 
 		m.ctx.View = m.ctx.Config.Defaults.View
-		m.currSectionId = m.getCurrentViewDefaultSection()
 		m.sidebar.IsOpen = msg.Config.Defaults.Preview.Open
+		if err := m.applyLaunchView(); err != nil {
+			m.ctx.Error = err
+		}
+		switch m.deepLinkRow.(type) {
+		case *prrow.Data:
+			m.ctx.View = config.PRsView
+			m.sidebar.IsOpen = true
+		case *data.IssueData:
+			m.ctx.View = config.IssuesView
+			m.sidebar.IsOpen = true
+		}
+		sectionId, err := m.launchSectionId()
+		if err != nil {
+			m.ctx.Error = err
+		}
+		m.currSectionId = sectionId
 		m.syncMainContentDimensions()
 
 		newSections, fetchSectionsCmds := m.fetchAllViewSections()
 		m.setCurrentViewSections(newSections)
-		m.tabs.SetCurrSectionId(1)
+		m.tabs.SetCurrSectionId(m.currSectionId)
 
 		if m.ctx.BackgroundSource != "bubbletea" {
 			log.Debugf("Setting markdownStyle in initMsg")
@@ -699,6 +943,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			markdown.InitializeMarkdownStyle(m.ctx)
 		}
 
+		if m.deepLinkRow != nil {
+			cmds = append(cmds, m.syncSidebar())
+		}
+
 		cmds = append(cmds, fetchSectionsCmds, m.tabs.Init(), fetchUser,
 			m.doRefreshAtInterval(), m.doUpdateFooterAtInterval())
 
@@ -736,12 +984,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, syncCmd)
 		}
 
+	case constants.TaskProgressMsg:
+		if task, ok := m.tasks[msg.TaskId]; ok {
+			task.StartText = msg.Text
+			m.tasks[msg.TaskId] = task
+			m.footer.SetRightSection(m.renderRunningTask())
+		}
+		scmd := m.updateSection(msg.SectionId, msg.SectionType, msg.Msg)
+		cmds = append(cmds, scmd, msg.Next, m.syncSidebar())
+
+	case section.ClearSelectionMsg:
+		cmd = m.updateSection(msg.SectionId, msg.SectionType, msg)
+
+	case section.SaveSectionMsg:
+		cmd = m.saveSection(msg)
+
+	case sectionSavedMsg:
+		cmd = m.onSectionSaved(msg)
+
+	case prview.PullRequestFilesFetchedMsg, prview.PendingReviewFetchedMsg, prview.JobLogFetchedMsg,
+		prview.CheckRunAnnotationsFetchedMsg:
+		m.prView, cmd = m.prView.Update(msg)
+		cmds = append(cmds, cmd, m.syncSidebar())
+
 	case prview.EnrichedPrMsg:
 		if msg.Err == nil {
 			m.prView.SetEnrichedPR(msg.Data)
 			m.prs[msg.Id].(*prssection.Model).EnrichPR(msg.Data)
 			syncCmd := m.syncSidebar()
-			cmds = append(cmds, syncCmd)
+			cmds = append(cmds, syncCmd, m.prView.FetchShownAnnotations())
 		} else {
 			log.Error("failed enriching pr", "err", msg.Err)
 		}
@@ -798,6 +1069,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			log.Error("failed fetching notification Issue", "err", msg.Err)
 		}
 
+	case notificationDiscussionFetchedMsg:
+		if msg.Err == nil {
+			m.notificationView.SetSubjectDiscussion(&msg.Discussion, msg.NotificationId)
+			keys.SetNotificationSubject(keys.NotificationSubjectNone)
+			width := m.sidebar.GetSidebarContentWidth()
+			m.discussionView.SetSectionId(0)
+			m.discussionView.SetRow(m.notificationView.GetSubjectDiscussion())
+			m.discussionView.SetWidth(width)
+			m.sidebar.SetContent(m.discussionView.View())
+			// Scroll to bottom if there's a latest comment (indicates new activity)
+			if msg.LatestCommentUrl != "" {
+				m.sidebar.ScrollToBottom()
+			}
+			m.markNotificationAsRead(msg.NotificationId)
+		} else {
+			log.Error("failed fetching notification Discussion", "err", msg.Err)
+		}
+
 	case notificationssection.UpdateNotificationReadStateMsg:
 		m.updateNotificationSections(msg)
 
@@ -820,10 +1109,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case section.SectionMsg:
 		cmd = m.updateRelevantSection(msg)
 
-		if msg.Id == m.currSectionId {
+		// Keep showing the deep linked row until the user moves away from it
+		if msg.Id == m.currSectionId && m.deepLinkRow == nil {
 			cmds = append(cmds, m.onViewedRowChanged())
 		}
 
+	case commandoutput.OutputMsg, commandoutput.FinishedMsg:
+		m.commandOutput, cmd = m.commandOutput.Update(msg)
+		if m.commandOutput.IsShown() {
+			m.syncSidebar()
+			m.sidebar.ScrollToBottom()
+		}
+
+	case customCommandFinishedMsg:
+		cmd = m.onCustomCommandFinished(msg)
+
 	case execProcessFinishedMsg, tea.FocusMsg:
 		if currSection != nil {
 			cmds = append(cmds, currSection.FetchNextPageSectionRows()...)
@@ -889,6 +1189,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.syncSidebar()
 	}
 
+	if m.discussionView.IsTextInputBoxFocused() {
+		m.discussionView, discussionViewCmd = m.discussionView.Update(msg)
+		m.syncSidebar()
+	}
+
 	if currSection != nil {
 		if currSection.IsPromptConfirmationFocused() {
 			m.footer.SetLeftSection(currSection.GetPromptConfirmation())
@@ -912,6 +1217,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		sectionCmd,
 		prViewCmd,
 		issueSidebarCmd,
+		discussionViewCmd,
 	)
 
 	return m, tea.Batch(cmds...)
@@ -998,6 +1304,22 @@ func (m Model) View() tea.View {
 		layers = append(layers, lipgloss.NewLayer(issueCmp).X(previewPos.X+3).Y(y))
 	}
 
+	discussionCmp := m.discussionView.ViewCompletions()
+	if discussionCmp != "" {
+		y := m.ctx.ScreenHeight - common.FooterHeight - m.discussionView.InputBoxLineFromButton() - common.InputBoxHeight - 6
+		layers = append(layers, lipgloss.NewLayer(discussionCmp).X(previewPos.X+3).Y(y))
+	}
+
+	if palette := m.commandPalette.View(); palette != "" {
+		x := max(0, (m.ctx.ScreenWidth-lipgloss.Width(palette))/2)
+		layers = append(layers, lipgloss.NewLayer(palette).X(x).Y(common.HeaderHeight))
+	}
+
+	if prompt := m.commandPrompt.View(); prompt != "" {
+		x := max(0, (m.ctx.ScreenWidth-lipgloss.Width(prompt))/2)
+		layers = append(layers, lipgloss.NewLayer(prompt).X(x).Y(common.HeaderHeight))
+	}
+
 	comp := lipgloss.NewCompositor(layers...)
 	v.SetContent(comp.Render())
 
@@ -1010,6 +1332,12 @@ type initMsg struct {
 }
 
 // Message types for notification subject fetching
+type sectionSavedMsg struct {
+	Section section.SaveSectionMsg
+	Path    string
+	Err     error
+}
+
 type notificationPRFetchedMsg struct {
 	NotificationId   string
 	PR               data.EnrichedPullRequestData
@@ -1017,6 +1345,13 @@ type notificationPRFetchedMsg struct {
 	Err              error
 }
 
+type notificationDiscussionFetchedMsg struct {
+	NotificationId   string
+	Discussion       data.DiscussionData
+	LatestCommentUrl string
+	Err              error
+}
+
 type notificationIssueFetchedMsg struct {
 	NotificationId   string
 	Issue            data.IssueData
@@ -1050,6 +1385,7 @@ func (m *Model) markNotificationAsRead(notificationId string) {
 }
 
 func (m *Model) onViewedRowChanged() tea.Cmd {
+	m.deepLinkRow = nil
 	m.prView.SetSummaryViewLess()
 	m.prView.GoToFirstTab()
 	sidebarCmd := m.syncSidebar()
@@ -1057,7 +1393,38 @@ func (m *Model) onViewedRowChanged() tea.Cmd {
 	m.sidebar.ScrollToTop()
 	m.notificationView.ResetSubject()
 	keys.SetNotificationSubject(keys.NotificationSubjectNone)
-	return tea.Batch(sidebarCmd, enrichCmd)
+	return tea.Batch(sidebarCmd, enrichCmd, m.prefetchNeighbours())
+}
+
+// prefetchedNeighbours is how many rows above and below the current one are
+// enriched ahead of the selection
+const prefetchedNeighbours = 2
+
+// prefetchNeighbours fetches the PRs or issues around the current row into
+// the enrichment cache, so moving the cursor onto them shows them right away
+func (m *Model) prefetchNeighbours() tea.Cmd {
+	if !m.sidebar.IsOpen {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	switch s := m.getCurrSection().(type) {
+	case *prssection.Model:
+		for _, pr := range s.UnenrichedNeighbours(prefetchedNeighbours) {
+			cmds = append(cmds, func() tea.Msg {
+				data.PrefetchPullRequest(pr.Url, pr.UpdatedAt)
+				return nil
+			})
+		}
+	case *issuessection.Model:
+		for _, issue := range s.UnenrichedNeighbours(prefetchedNeighbours) {
+			cmds = append(cmds, func() tea.Msg {
+				data.PrefetchIssue(issue.Url, issue.UpdatedAt)
+				return nil
+			})
+		}
+	}
+	return tea.Batch(cmds...)
 }
 
 func (m *Model) onWindowSizeChanged(msg tea.WindowSizeMsg) {
@@ -1084,8 +1451,12 @@ func (m *Model) syncProgramContext() {
 	m.sidebar.UpdateProgramContext(m.ctx)
 	m.prView.UpdateProgramContext(m.ctx)
 	m.issueSidebar.UpdateProgramContext(m.ctx)
+	m.discussionView.UpdateProgramContext(m.ctx)
 	m.branchSidebar.UpdateProgramContext(m.ctx)
 	m.notificationView.UpdateProgramContext(m.ctx)
+	m.commandPalette.UpdateProgramContext(m.ctx)
+	m.commandOutput.UpdateProgramContext(m.ctx)
+	m.commandPrompt.UpdateProgramContext(m.ctx)
 }
 
 func (m *Model) updateSection(id int, sType string, msg tea.Msg) (cmd tea.Cmd) {
@@ -1105,6 +1476,9 @@ func (m *Model) updateSection(id int, sType string, msg tea.Msg) (cmd tea.Cmd) {
 	case issuessection.SectionType:
 		updatedSection, cmd = m.issues[id].Update(msg)
 		m.issues[id] = updatedSection
+	case discussionssection.SectionType:
+		updatedSection, cmd = m.discussions[id].Update(msg)
+		m.discussions[id] = updatedSection
 	}
 
 	currSection := m.getCurrSection()
@@ -1214,6 +1588,26 @@ func (m *Model) openSidebarForPRInput(setFunc func(bool) tea.Cmd) tea.Cmd {
 }
 
 func (m *Model) openSidebarForInput(setFunc func(bool) tea.Cmd) tea.Cmd {
+	m.prView.SetSelectedPrs(nil)
+	m.issueSidebar.SetSelectedIssues(nil)
+	return m.showSidebarInput(setFunc)
+}
+
+// openSidebarForSelectionInput opens the sidebar input of an action that runs
+// over the rows selected in the current section, or over the current row if
+// none is selected
+func (m *Model) openSidebarForSelectionInput(setFunc func(bool) tea.Cmd) tea.Cmd {
+	switch s := m.getCurrSection().(type) {
+	case *prssection.Model:
+		m.prView.GoToFirstTab()
+		m.prView.SetSelectedPrs(s.GetSelectedPrs())
+	case *issuessection.Model:
+		m.issueSidebar.SetSelectedIssues(s.GetSelectedIssues())
+	}
+	return m.showSidebarInput(setFunc)
+}
+
+func (m *Model) showSidebarInput(setFunc func(bool) tea.Cmd) tea.Cmd {
 	m.sidebar.IsOpen = true
 	cmd := setFunc(true)
 	m.syncMainContentDimensions()
@@ -1223,7 +1617,8 @@ func (m *Model) openSidebarForInput(setFunc func(bool) tea.Cmd) tea.Cmd {
 }
 
 func (m *Model) backToNotification() tea.Cmd {
-	if m.notificationView.GetSubjectPR() == nil && m.notificationView.GetSubjectIssue() == nil {
+	if m.notificationView.GetSubjectPR() == nil && m.notificationView.GetSubjectIssue() == nil &&
+		m.notificationView.GetSubjectDiscussion() == nil {
 		return nil
 	}
 
@@ -1250,6 +1645,13 @@ func (m *Model) syncSidebar() tea.Cmd {
 	width := m.sidebar.GetSidebarContentWidth()
 	var cmd tea.Cmd
 
+	// The output of a custom command is shown until it's closed
+	if m.commandOutput.IsShown() {
+		m.commandOutput.SetWidth(width)
+		m.sidebar.SetContent(m.commandOutput.View())
+		return nil
+	}
+
 	if currRowData == nil {
 		m.sidebar.SetContent("")
 		return nil
@@ -1277,6 +1679,15 @@ func (m *Model) syncSidebar() tea.Cmd {
 		if m.issueSidebar.IsTextInputBoxFocused() {
 			m.sidebar.ScrollToBottom()
 		}
+	case *data.DiscussionData:
+		m.discussionView.SetSectionId(m.currSectionId)
+		m.discussionView.SetRow(row)
+		m.discussionView.SetWidth(width)
+		m.sidebar.SetContent(m.discussionView.View())
+		// Scroll to bottom if in input mode to keep inputbox visible
+		if m.discussionView.IsTextInputBoxFocused() {
+			m.sidebar.ScrollToBottom()
+		}
 	case *notificationrow.Data:
 		notifId := row.GetId()
 
@@ -1301,6 +1712,11 @@ func (m *Model) syncSidebar() tea.Cmd {
 				if m.issueSidebar.IsTextInputBoxFocused() {
 					m.sidebar.ScrollToBottom()
 				}
+			} else if m.notificationView.GetSubjectDiscussion() != nil {
+				m.discussionView.SetSectionId(0)
+				m.discussionView.SetRow(m.notificationView.GetSubjectDiscussion())
+				m.discussionView.SetWidth(width)
+				m.sidebar.SetContent(m.discussionView.View())
 			}
 			return nil
 		}
@@ -1344,6 +1760,8 @@ func (m *Model) renderNotificationPrompt(row *notificationrow.Data) string {
 	enterAction := "view"
 	if subjectType == "Issue" {
 		typeName = "Issue"
+	} else if canPreviewDiscussion(row) {
+		typeName = "Discussion"
 	} else if subjectType != "PullRequest" {
 		typeName = subjectType
 		enterAction = "open in browser"
@@ -1417,6 +1835,13 @@ func (m *Model) renderNotificationPrompt(row *notificationrow.Data) string {
 	return content.String()
 }
 
+// canPreviewDiscussion is true for discussion notifications whose URL points
+// at the discussion itself, the API doesn't always return it
+func canPreviewDiscussion(row *notificationrow.Data) bool {
+	return row.GetSubjectType() == "Discussion" &&
+		!strings.HasSuffix(row.GetUrl(), "/discussions")
+}
+
 // loadNotificationContent fetches and displays notification content, marking it as read
 func (m *Model) loadNotificationContent() tea.Cmd {
 	currRowData := m.getCurrRowData()
@@ -1429,6 +1854,10 @@ func (m *Model) loadNotificationContent() tea.Cmd {
 	subjectType := row.GetSubjectType()
 	subjectUrl := row.GetUrl()
 	latestCommentUrl := row.GetLatestCommentUrl()
+	host := data.HostOf(subjectUrl)
+	// The section fetched the subject when it loaded to count its new
+	// comments, it's reused unless it was updated since
+	updatedAt := row.GetUpdatedAt()
 
 	// Show loading indicator
 	width := m.sidebar.GetSidebarContentWidth()
@@ -1440,14 +1869,18 @@ func (m *Model) loadNotificationContent() tea.Cmd {
 	case "PullRequest":
 		return tea.Batch(
 			func() tea.Msg {
-				_ = data.MarkNotificationRead(notifId)
+				_ = data.MarkNotificationRead(notifId, host)
 				return notificationssection.UpdateNotificationReadStateMsg{
 					Id:     notifId,
 					Unread: false,
 				}
 			},
 			func() tea.Msg {
-				pr, err := data.FetchPullRequest(subjectUrl)
+				pr, ok := data.CachedPullRequest(subjectUrl, updatedAt)
+				var err error
+				if !ok {
+					pr, err = data.FetchPullRequest(subjectUrl)
+				}
 				return notificationPRFetchedMsg{
 					NotificationId:   notifId,
 					PR:               pr,
@@ -1459,14 +1892,18 @@ func (m *Model) loadNotificationContent() tea.Cmd {
 	case "Issue":
 		return tea.Batch(
 			func() tea.Msg {
-				_ = data.MarkNotificationRead(notifId)
+				_ = data.MarkNotificationRead(notifId, host)
 				return notificationssection.UpdateNotificationReadStateMsg{
 					Id:     notifId,
 					Unread: false,
 				}
 			},
 			func() tea.Msg {
-				issue, err := data.FetchIssue(subjectUrl)
+				issue, ok := data.CachedIssue(subjectUrl, updatedAt)
+				var err error
+				if !ok {
+					issue, err = data.FetchIssue(subjectUrl)
+				}
 				return notificationIssueFetchedMsg{
 					NotificationId:   notifId,
 					Issue:            issue,
@@ -1475,12 +1912,43 @@ func (m *Model) loadNotificationContent() tea.Cmd {
 				}
 			},
 		)
+	case "Discussion":
+		if !canPreviewDiscussion(row) {
+			return tea.Batch(
+				func() tea.Msg {
+					_ = data.MarkNotificationRead(notifId, host)
+					return notificationssection.UpdateNotificationReadStateMsg{
+						Id:     notifId,
+						Unread: false,
+					}
+				},
+				m.openBrowser(),
+			)
+		}
+		return tea.Batch(
+			func() tea.Msg {
+				_ = data.MarkNotificationRead(notifId, host)
+				return notificationssection.UpdateNotificationReadStateMsg{
+					Id:     notifId,
+					Unread: false,
+				}
+			},
+			func() tea.Msg {
+				discussion, err := data.FetchDiscussion(subjectUrl)
+				return notificationDiscussionFetchedMsg{
+					NotificationId:   notifId,
+					Discussion:       discussion,
+					LatestCommentUrl: latestCommentUrl,
+					Err:              err,
+				}
+			},
+		)
 	default:
-		// For discussions, releases, etc. - mark as read and open in browser
+		// For releases, commits, etc. - mark as read and open in browser
 		// since we can't show rich content for these types
 		return tea.Batch(
 			func() tea.Msg {
-				_ = data.MarkNotificationRead(notifId)
+				_ = data.MarkNotificationRead(notifId, host)
 				return notificationssection.UpdateNotificationReadStateMsg{
 					Id:     notifId,
 					Unread: false,
@@ -1511,6 +1979,10 @@ func (m *Model) fetchAllViewSections() ([]section.Section, tea.Cmd) {
 		s, prcmds := prssection.FetchAllSections(m.ctx, m.prs)
 		cmds = append(cmds, prcmds)
 		return s, tea.Batch(cmds...)
+	case config.DiscussionsView:
+		s, discussioncmds := discussionssection.FetchAllSections(m.ctx)
+		cmds = append(cmds, discussioncmds)
+		return s, tea.Batch(cmds...)
 	default:
 		s, issuecmds := issuessection.FetchAllSections(m.ctx)
 		cmds = append(cmds, issuecmds)
@@ -1532,6 +2004,8 @@ func (m *Model) getCurrentViewSections() []section.Section {
 		return m.notifications
 	case config.PRsView:
 		return m.prs
+	case config.DiscussionsView:
+		return m.discussions
 	default:
 		return m.issues
 	}
@@ -1604,6 +2078,22 @@ func (m *Model) setCurrentViewSections(newSections []section.Section) {
 		}
 		m.prs = append(s, newSections...)
 		newSections = m.prs
+	} else if m.ctx.View == config.DiscussionsView {
+		if missingSearchSection {
+			search := discussionssection.NewModel(
+				0,
+				m.ctx,
+				config.DiscussionsSectionConfig{
+					Title:   "",
+					Filters: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.discussions = append(s, newSections...)
+		newSections = m.discussions
 	} else {
 		if missingSearchSection {
 			search := issuessection.NewModel(
@@ -1625,37 +2115,127 @@ func (m *Model) setCurrentViewSections(newSections []section.Section) {
 	m.tabs.SetSections(newSections)
 }
 
-func (m *Model) switchSelectedView() tea.Cmd {
-	repoFF := config.IsFeatureEnabled(config.FF_REPO_VIEW)
+// saveSection writes the section to the config file in the background
+func (m *Model) saveSection(msg section.SaveSectionMsg) tea.Cmd {
+	location := config.Location{RepoPath: m.ctx.RepoPath, ConfigFlag: m.ctx.ConfigFlag}
+	cfg := m.ctx.Config
+	return func() tea.Msg {
+		var path string
+		var err error
+		switch msg.SectionType {
+		case prssection.SectionType:
+			path, err = config.SavePrsSection(location, cfg, config.PrsSectionConfig{
+				Title:   msg.Title,
+				Filters: msg.Filters,
+				Host:    msg.Host,
+			})
+		case issuessection.SectionType:
+			path, err = config.SaveIssuesSection(location, cfg, config.IssuesSectionConfig{
+				Title:   msg.Title,
+				Filters: msg.Filters,
+				Host:    msg.Host,
+			})
+		case notificationssection.SectionType:
+			path, err = config.SaveNotificationsSection(
+				location,
+				cfg,
+				config.NotificationsSectionConfig{
+					Title:   msg.Title,
+					Filters: msg.Filters,
+					Host:    msg.Host,
+				},
+			)
+		default:
+			err = fmt.Errorf("%s sections can't be saved", msg.SectionType)
+		}
+		return sectionSavedMsg{Section: msg, Path: path, Err: err}
+	}
+}
 
+// onSectionSaved adds the tab of a section saved to the config file, without
+// reloading the other sections
+func (m *Model) onSectionSaved(msg sectionSavedMsg) tea.Cmd {
+	if msg.Err != nil {
+		return m.notifyErr(fmt.Sprintf("Failed saving section: %v", msg.Err))
+	}
+
+	var saved section.Section
+	var view config.ViewType
+	switch msg.Section.SectionType {
+	case prssection.SectionType:
+		cfg := config.PrsSectionConfig{
+			Title:   msg.Section.Title,
+			Filters: msg.Section.Filters,
+			Host:    msg.Section.Host,
+		}
+		m.ctx.Config.PRSections = append(m.ctx.Config.PRSections, cfg)
+		sectionModel := prssection.NewModel(len(m.prs), m.ctx, cfg, time.Now(), time.Now())
+		m.prs = append(m.prs, &sectionModel)
+		saved, view = &sectionModel, config.PRsView
+	case issuessection.SectionType:
+		cfg := config.IssuesSectionConfig{
+			Title:   msg.Section.Title,
+			Filters: msg.Section.Filters,
+			Host:    msg.Section.Host,
+		}
+		m.ctx.Config.IssuesSections = append(m.ctx.Config.IssuesSections, cfg)
+		sectionModel := issuessection.NewModel(len(m.issues), m.ctx, cfg, time.Now(), time.Now())
+		m.issues = append(m.issues, &sectionModel)
+		saved, view = &sectionModel, config.IssuesView
+	case notificationssection.SectionType:
+		cfg := config.NotificationsSectionConfig{
+			Title:   msg.Section.Title,
+			Filters: msg.Section.Filters,
+			Host:    msg.Section.Host,
+		}
+		m.ctx.Config.NotificationsSections = append(m.ctx.Config.NotificationsSections, cfg)
+		sectionModel := notificationssection.NewModel(len(m.notifications), m.ctx, cfg, time.Now())
+		m.notifications = append(m.notifications, &sectionModel)
+		saved, view = &sectionModel, config.NotificationsView
+	default:
+		return nil
+	}
+
+	if m.ctx.View == view {
+		m.tabs.SetSections(m.getCurrentViewSections())
+	}
+	return tea.Batch(append(
+		saved.FetchNextPageSectionRows(),
+		m.notify(fmt.Sprintf("Saved section %q to %s", msg.Section.Title, msg.Path)),
+	)...)
+}
+
+// viewCycle returns the views switched between, in order. The discussions
+// view is only part of it once discussions sections are configured.
+func (m *Model) viewCycle() []config.ViewType {
+	cycle := []config.ViewType{config.NotificationsView, config.PRsView, config.IssuesView}
+	if m.ctx.Config != nil && len(m.ctx.Config.DiscussionsSections) > 0 {
+		cycle = append(cycle, config.DiscussionsView)
+	}
+	if config.IsFeatureEnabled(config.FF_REPO_VIEW) {
+		cycle = append(cycle, config.RepoView)
+	}
+	return cycle
+}
+
+func (m *Model) switchSelectedView() tea.Cmd {
 	// Reset notification subject when leaving notifications view
 	if m.ctx.View == config.NotificationsView {
 		keys.SetNotificationSubject(keys.NotificationSubjectNone)
 		m.notificationView.ClearSubject()
 	}
 
-	// View cycle: Notifications → PRs → Issues (→ Repo if enabled) → Notifications
-	if repoFF {
-		switch m.ctx.View {
-		case config.NotificationsView:
-			m.ctx.View = config.PRsView
-		case config.PRsView:
-			m.ctx.View = config.IssuesView
-		case config.IssuesView:
-			m.ctx.View = config.RepoView
-		case config.RepoView:
-			m.ctx.View = config.NotificationsView
-		}
-	} else {
-		switch m.ctx.View {
-		case config.NotificationsView:
-			m.ctx.View = config.PRsView
-		case config.PRsView:
-			m.ctx.View = config.IssuesView
-		default:
-			m.ctx.View = config.NotificationsView
+	// View cycle: Notifications → PRs → Issues (→ Discussions if configured)
+	// (→ Repo if enabled) → Notifications
+	cycle := m.viewCycle()
+	next := config.NotificationsView
+	for i, view := range cycle {
+		if view == m.ctx.View {
+			next = cycle[(i+1)%len(cycle)]
+			break
 		}
 	}
+	m.ctx.View = next
 
 	m.syncMainContentDimensions()
 	m.setCurrSectionId(m.getCurrentViewDefaultSection())
@@ -1700,6 +2280,14 @@ func (m *Model) isUserDefinedKeybinding(msg tea.KeyMsg) bool {
 		}
 	}
 
+	if m.ctx.View == config.DiscussionsView {
+		for _, keybinding := range m.ctx.Config.Keybindings.Discussions {
+			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
+				return true
+			}
+		}
+	}
+
 	if m.ctx.View == config.RepoView {
 		for _, keybinding := range m.ctx.Config.Keybindings.Branches {
 			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
@@ -1819,13 +2407,15 @@ func fetchUser() tea.Msg {
 
 type intervalRefresh time.Time
 
+// doRefreshAtInterval schedules the next refetch of the sections, backing off
+// when the rate limit budget runs low
 func (m *Model) doRefreshAtInterval() tea.Cmd {
 	if m.ctx.Config.Defaults.RefetchIntervalMinutes == 0 {
 		return nil
 	}
 
 	return tea.Tick(
-		time.Minute*time.Duration(m.ctx.Config.Defaults.RefetchIntervalMinutes),
+		data.RefetchDelay(time.Minute*time.Duration(m.ctx.Config.Defaults.RefetchIntervalMinutes)),
 		func(t time.Time) tea.Msg {
 			return intervalRefresh(t)
 		},
@@ -1891,7 +2481,7 @@ func (m *Model) executeNotificationAction(action string) tea.Cmd {
 		}
 	case "pr_merge":
 		if pr != nil {
-			return tasks.MergePR(m.ctx, sid, pr)
+			return tasks.MergePR(m.ctx, sid, pr, data.MergeOptions{})
 		}
 	case "pr_update":
 		if pr != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branchsidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/footer"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issueview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/notificationrow"
@@ -330,6 +331,7 @@ func TestNotificationView_PRViewTabNavigation(t *testing.T) {
 		prView:           prview.NewModel(ctx),
 		sidebar:          sidebarModel,
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
 
@@ -384,6 +386,7 @@ func TestNotificationView_EnterKeyWorksAfterViewingPR(t *testing.T) {
 		footer:           footer.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 		sidebar:          sidebarModel,
 		tabs:             tabs.NewModel(ctx),
@@ -455,6 +458,7 @@ func TestNotificationView_EnterKeyWorksAfterViewingIssue(t *testing.T) {
 		footer:           footer.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 		sidebar:          sidebarModel,
 		tabs:             tabs.NewModel(ctx),
@@ -527,6 +531,7 @@ func TestNotificationView_BackKeyClearsPRSubjectAndRestoresNotificationActions(t
 		footer:           footer.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 		sidebar:          sidebarModel,
 		tabs:             tabs.NewModel(ctx),
@@ -604,6 +609,7 @@ func TestNotificationView_BackKeyClearsIssueSubject(t *testing.T) {
 		footer:           footer.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 		sidebar:          sidebarModel,
 		tabs:             tabs.NewModel(ctx),
@@ -667,13 +673,14 @@ func TestNavigationKeysWithNilSection(t *testing.T) {
 	sidebarModel.UpdateProgramContext(ctx)
 
 	m := Model{
-		ctx:          ctx,
-		keys:         keys.Keys,
-		footer:       footer.NewModel(ctx),
-		prView:       prview.NewModel(ctx),
-		issueSidebar: issueview.NewModel(ctx),
-		sidebar:      sidebarModel,
-		tabs:         tabs.NewModel(ctx),
+		ctx:            ctx,
+		keys:           keys.Keys,
+		footer:         footer.NewModel(ctx),
+		prView:         prview.NewModel(ctx),
+		issueSidebar:   issueview.NewModel(ctx),
+		discussionView: discussionview.NewModel(ctx),
+		sidebar:        sidebarModel,
+		tabs:           tabs.NewModel(ctx),
 	}
 	// No sections added — currSection will be nil
 
@@ -853,6 +860,11 @@ func TestRepoPathConfigTakesPriority(t *testing.T) {
 	require.Equal(t, "cd /configured/path && gh issue edit 42", result)
 }
 
+func TestLastNonEmptyLine(t *testing.T) {
+	require.Equal(t, "deployed to staging", lastNonEmptyLine("building\ndeployed to staging\n\n"))
+	require.Equal(t, "", lastNonEmptyLine(""))
+}
+
 func TestSyncMainContentWidth(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -1002,6 +1014,7 @@ func TestSyncSidebar_NoOpWhenSidebarClosed(t *testing.T) {
 		tabs:             tabs.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		branchSidebar:    branchsidebar.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
@@ -1158,6 +1171,7 @@ func TestTogglePreviewPosition_NoOpWhenSidebarClosed(t *testing.T) {
 		tabs:             tabs.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		branchSidebar:    branchsidebar.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
@@ -1231,6 +1245,7 @@ func TestTogglePreviewPosition_TogglesWhenSidebarOpen(t *testing.T) {
 		tabs:             tabs.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		branchSidebar:    branchsidebar.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
@@ -1315,6 +1330,7 @@ func TestView_ClosingSidebarFromBottomMode_NoExtraLine(t *testing.T) {
 		tabs:             tabs.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		branchSidebar:    branchsidebar.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
@@ -1649,6 +1665,7 @@ func TestRefresh_ClearsEnrichmentCache(t *testing.T) {
 		tabs:             tabs.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		branchSidebar:    branchsidebar.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
@@ -1709,6 +1726,7 @@ func TestRefreshAll_ClearsEnrichmentCache(t *testing.T) {
 		tabs:             tabs.NewModel(ctx),
 		prView:           prview.NewModel(ctx),
 		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
 		branchSidebar:    branchsidebar.NewModel(ctx),
 		notificationView: notificationview.NewModel(ctx),
 	}
@@ -2261,3 +2279,295 @@ func TestNotificationCommandTemplateVariables(t *testing.T) {
 		})
 	}
 }
+
+func TestViewCycle_IncludesDiscussionsWhenConfigured(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag: "../config/testdata/test-config.yml",
+	})
+	require.NoError(t, err)
+
+	m := Model{ctx: &context.ProgramContext{Config: &cfg}}
+	require.NotContains(t, m.viewCycle(), config.DiscussionsView,
+		"discussions view should be skipped without discussions sections")
+
+	cfg.DiscussionsSections = []config.DiscussionsSectionConfig{
+		{Title: "Q&A", Filters: "repo:dlvhdr/gh-dash"},
+	}
+	require.Equal(t,
+		[]config.ViewType{
+			config.NotificationsView,
+			config.PRsView,
+			config.IssuesView,
+			config.DiscussionsView,
+		},
+		m.viewCycle(),
+	)
+}
+
+func TestDeepLinkRow_ShownUntilAnotherRowIsViewed(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag: "../config/testdata/test-config.yml",
+	})
+	require.NoError(t, err)
+
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		View:   config.PRsView,
+	}
+	ctx.Theme = theme.ParseTheme(ctx.Config)
+	ctx.Styles = context.InitStyles(ctx.Theme)
+
+	m := Model{
+		ctx:            ctx,
+		keys:           keys.Keys,
+		prView:         prview.NewModel(ctx),
+		issueSidebar:   issueview.NewModel(ctx),
+		discussionView: discussionview.NewModel(ctx),
+		sidebar:        sidebar.NewModel(),
+		tabs:           tabs.NewModel(ctx),
+		footer:         footer.NewModel(ctx),
+	}
+	prSec := prssection.NewModel(0, ctx, config.PrsSectionConfig{}, time.Now(), time.Now())
+	m.prs = []section.Section{&prSec}
+
+	pr := &prrow.Data{Primary: &data.PullRequestData{Number: 123}}
+	m.SetDeepLinkRow(pr)
+	require.Equal(t, pr, m.getCurrRowData(), "the deep linked PR should be the current row")
+
+	updated, _ := m.Update(section.SectionMsg{Id: 0, Type: prssection.SectionType})
+	m = updated.(Model)
+	require.Equal(t, pr, m.getCurrRowData(),
+		"fetching the section rows shouldn't replace the deep linked PR")
+
+	m.onViewedRowChanged()
+	require.Nil(t, m.deepLinkRow, "viewing another row should clear the deep linked PR")
+}
+
+func TestLaunchOptions(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag: "../config/testdata/test-config.yml",
+	})
+	require.NoError(t, err)
+	cfg.IssuesSections = []config.IssuesSectionConfig{
+		{Title: "Mine", Filters: "author:@me"},
+		{Title: "Assigned", Filters: "assignee:@me"},
+	}
+
+	tests := []struct {
+		name        string
+		opts        LaunchOptions
+		wantView    config.ViewType
+		wantSection int
+		wantErr     bool
+	}{
+		{
+			name:        "section by title",
+			opts:        LaunchOptions{View: config.IssuesView, Section: "assigned"},
+			wantView:    config.IssuesView,
+			wantSection: 2,
+		},
+		{
+			name:        "section by index",
+			opts:        LaunchOptions{View: config.IssuesView, Section: "2"},
+			wantView:    config.IssuesView,
+			wantSection: 2,
+		},
+		{
+			name:        "unknown section falls back to the default",
+			opts:        LaunchOptions{View: config.IssuesView, Section: "Nope"},
+			wantView:    config.IssuesView,
+			wantSection: 1,
+			wantErr:     true,
+		},
+		{
+			name:        "unavailable view is ignored",
+			opts:        LaunchOptions{View: config.DiscussionsView},
+			wantView:    config.PRsView,
+			wantSection: 1,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{ctx: &context.ProgramContext{Config: &cfg, View: config.PRsView}}
+			m.SetLaunchOptions(tt.opts)
+
+			viewErr := m.applyLaunchView()
+			sectionId, sectionErr := m.launchSectionId()
+
+			require.Equal(t, tt.wantView, m.ctx.View)
+			require.Equal(t, tt.wantSection, sectionId)
+			require.Equal(t, tt.wantErr, viewErr != nil || sectionErr != nil)
+		})
+	}
+}
+
+func TestOnSectionSaved_AddsTab(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag: "../config/testdata/test-config.yml",
+	})
+	require.NoError(t, err)
+	configured := len(cfg.PRSections)
+
+	ctx := &context.ProgramContext{
+		Config:    &cfg,
+		View:      config.PRsView,
+		StartTask: func(task context.Task) tea.Cmd { return nil },
+	}
+	ctx.Theme = theme.ParseTheme(ctx.Config)
+	ctx.Styles = context.InitStyles(ctx.Theme)
+
+	search := prssection.NewModel(
+		0,
+		ctx,
+		config.PrsSectionConfig{Filters: "is:open label:bug"},
+		time.Now(),
+		time.Now(),
+	)
+	m := Model{
+		ctx:    ctx,
+		keys:   keys.Keys,
+		prs:    []section.Section{&search},
+		footer: footer.NewModel(ctx),
+		tabs:   tabs.NewModel(ctx),
+	}
+
+	m.onSectionSaved(sectionSavedMsg{
+		Section: section.SaveSectionMsg{
+			SectionType: prssection.SectionType,
+			Title:       "Bugs",
+			Filters:     "is:open label:bug",
+		},
+		Path: "config.yml",
+	})
+
+	require.Len(t, m.prs, 2)
+	require.Equal(t, 1, m.prs[1].GetId())
+	require.Equal(t, "Bugs", m.prs[1].GetConfig().Title)
+	require.Len(t, cfg.PRSections, configured+1)
+	require.Equal(t, "is:open label:bug", cfg.PRSections[configured].Filters)
+}
+
+func TestNotificationView_DiscussionFetchedShowsDiscussion(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		View:   config.NotificationsView,
+	}
+	ctx.Theme = theme.ParseTheme(ctx.Config)
+	ctx.Styles = context.InitStyles(ctx.Theme)
+
+	sidebarModel := sidebar.NewModel()
+	sidebarModel.UpdateProgramContext(ctx)
+	sidebarModel.IsOpen = true
+
+	m := Model{
+		ctx:              ctx,
+		keys:             keys.Keys,
+		footer:           footer.NewModel(ctx),
+		prView:           prview.NewModel(ctx),
+		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
+		notificationView: notificationview.NewModel(ctx),
+		sidebar:          sidebarModel,
+		tabs:             tabs.NewModel(ctx),
+	}
+
+	notifSec := notificationssection.NewModel(
+		0,
+		ctx,
+		config.NotificationsSectionConfig{},
+		time.Now(),
+	)
+	notifSec.Notifications = []notificationrow.Data{
+		{
+			Notification: data.NotificationData{
+				Id: "test-notification-3",
+				Subject: data.NotificationSubject{
+					Title: "RFC: Plugins",
+					Url:   "https://api.github.com/repos/owner/repo/discussions/12",
+					Type:  "Discussion",
+				},
+				Repository: data.NotificationRepository{
+					FullName: "owner/repo",
+				},
+				Unread: true,
+			},
+		},
+	}
+	notifSec.Table.SetRows(notifSec.BuildRows())
+	m.notifications = []section.Section{&notifSec}
+
+	row := m.getCurrRowData().(*notificationrow.Data)
+	require.True(t, canPreviewDiscussion(row))
+
+	updated, _ := m.Update(notificationDiscussionFetchedMsg{
+		NotificationId: "test-notification-3",
+		Discussion: data.DiscussionData{
+			Title: "RFC: Plugins",
+			Url:   "https://github.com/owner/repo/discussions/12",
+		},
+	})
+	m = updated.(Model)
+
+	require.NotNil(t, m.notificationView.GetSubjectDiscussion())
+	require.Equal(t, "test-notification-3", m.notificationView.GetSubjectId())
+
+	// Going back shows the notification prompt again
+	m.backToNotification()
+	require.Nil(t, m.notificationView.GetSubjectDiscussion())
+}
+
+func TestPRViewModes_IgnoredOutsidePRsView(t *testing.T) {
+	cfg, err := config.ParseConfig(config.Location{
+		ConfigFlag:       "../config/testdata/test-config.yml",
+		SkipGlobalConfig: true,
+	})
+	require.NoError(t, err)
+
+	ctx := &context.ProgramContext{
+		Config: &cfg,
+		View:   config.PRsView,
+	}
+	ctx.Theme = theme.ParseTheme(ctx.Config)
+	ctx.Styles = context.InitStyles(ctx.Theme)
+
+	sidebarModel := sidebar.NewModel()
+	sidebarModel.UpdateProgramContext(ctx)
+	sidebarModel.IsOpen = true
+
+	m := Model{
+		ctx:              ctx,
+		keys:             keys.Keys,
+		footer:           footer.NewModel(ctx),
+		prView:           prview.NewModel(ctx),
+		issueSidebar:     issueview.NewModel(ctx),
+		discussionView:   discussionview.NewModel(ctx),
+		notificationView: notificationview.NewModel(ctx),
+		sidebar:          sidebarModel,
+		tabs:             tabs.NewModel(ctx),
+	}
+	m.prView.SetRow(&prrow.Data{Primary: &data.PullRequestData{
+		Number: 1,
+		Url:    "https://github.com/owner/repo/pull/1",
+	}})
+	m.prView.StartNavigatingChecks()
+	require.True(t, m.prView.IsNavigatingChecks())
+
+	// Leaving the PRs view keeps the mode, but its keys go to the new view
+	m.ctx.View = config.IssuesView
+	updated, _ := m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = updated.(Model)
+	require.True(t, m.prView.IsNavigatingChecks())
+
+	m.ctx.View = config.PRsView
+	updated, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = updated.(Model)
+	require.False(t, m.prView.IsNavigatingChecks())
+}